@@ -0,0 +1,120 @@
+package packed
+
+import (
+	"fmt"
+	"reflect"
+)
+
+type structAlignment struct {
+	maximum int
+}
+
+var (
+	Packed  = structAlignment{maximum: 1}
+	Natural = structAlignment{}
+)
+
+func Pack(maximum int) structAlignment {
+
+	if maximum < 1 || maximum&(maximum-1) != 0 {
+		panic(fmt.Sprintf("pack value must be a power of two, got %d", maximum))
+	}
+
+	return structAlignment{maximum: maximum}
+}
+
+type AlignmentInterface interface {
+	Alignment() int
+}
+
+func Align(alignment int) fieldOption {
+	return func(definition *packedProperty) {
+
+		if alignment < 1 || alignment&(alignment-1) != 0 {
+			panic(fmt.Sprintf("alignment must be a power of two, got %d", alignment))
+		}
+
+		if definition.kind == kindBitField {
+			panic("alignment cannot be set for bit fields")
+		}
+
+		definition.align = alignment
+	}
+}
+
+func converterAlignment(converter any, reciever reflect.Type) int {
+
+	if alignment, ok := converter.(AlignmentInterface); ok {
+		return alignment.Alignment()
+	}
+
+	switch reciever.Kind() {
+
+	case reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+
+		switch size := converter.(interface{ Size() int }).Size(); size {
+		case 1, 2, 4, 8:
+			return size
+		}
+	}
+
+	return 1
+}
+
+func naturalAlignment(packed any, kind kind, reciever reflect.Type) int {
+
+	switch kind {
+
+	case kindStruct:
+		return packed.(packedStruct).alignment
+
+	case kindArray:
+		array := packed.(packedArray)
+		return naturalAlignment(array.Element, array.ElementKind, nil)
+
+	case kindConverter:
+		if reciever == nil {
+			reciever, _ = implementsConverterInterface(packed)
+		}
+		return converterAlignment(packed, reciever)
+
+	case kindConverterCast:
+		cast := packed.(converterCast)
+		return converterAlignment(cast.converter.instance, cast.reciever)
+
+	case kindType:
+		if alignment, ok := packed.(AlignmentInterface); ok {
+			return alignment.Alignment()
+		}
+
+	case kindBitFieldGroup:
+		alignment := 1
+
+		for _, field := range packed.(packedBitFieldGroup).fields {
+			if size := int(field.reflection.Size()); size > alignment && size <= 8 {
+				alignment = size
+			}
+		}
+
+		return alignment
+	}
+
+	return 1
+}
+
+func (a structAlignment) member(property packedProperty) int {
+
+	if property.align > 0 {
+		return property.align
+	}
+
+	alignment := naturalAlignment(property.packed, property.kind, property.recieverType)
+
+	if a.maximum > 0 && alignment > a.maximum {
+		return a.maximum
+	}
+
+	return alignment
+}
@@ -0,0 +1,28 @@
+package packed
+
+import "testing"
+
+func TestAlignmentAfterVariableProperty(t *testing.T) {
+
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Errorf("expected a panic for an aligned property after an optional property")
+			}
+		}()
+
+		AlignedStruct("AlignedAfterOptional", false, Natural,
+			Field("Has", Boolean),
+			Field("Flags", Uint8),
+			Field("When", Uint16, When("Has")),
+			Field("Value", Uint32),
+		)
+	}()
+
+	AlignedStruct("UnalignedAfterOptional", false, Natural,
+		Field("Has", Boolean),
+		Field("Flags", Uint8),
+		Field("When", Uint16, When("Has")),
+		Field("Value", Uint8),
+	)
+}
@@ -3,6 +3,7 @@ package packed
 import (
 	"bytes"
 	"fmt"
+	"reflect"
 )
 
 type packedArray struct {
@@ -11,6 +12,7 @@ type packedArray struct {
 	ElementKind  kind
 	ElementSize  int
 	recieverType string
+	fixedString  *packedFixedString
 }
 
 func (a packedArray) Size() int {
@@ -22,18 +24,21 @@ func getArrayRecieverType(propertyType any) string {
 	kind, recieverType, propertyType := validatePropertyType(propertyType)
 
 	if kind == kindArray {
-		array := propertyType.(packedArray)
-		return fmt.Sprintf("[%d]%s", array.Length, getArrayRecieverType(array.Element))
+		return propertyType.(packedArray).recieverType
 	}
 
 	if kind == kindStruct {
 		return propertyType.(packedStruct).name
 	}
 
+	if kind == kindConverterCast {
+		return propertyType.(converterCast).targetName()
+	}
+
 	return recieverType.String()
 }
 
-func Array(length int, elementType any) packedArray {
+func validateElementType(elementType any) (kind, any, int) {
 
 	kind, _, elementType := validatePropertyType(elementType)
 
@@ -41,6 +46,34 @@ func Array(length int, elementType any) packedArray {
 		panic("bit fields as direct array elements are not supported")
 	}
 
+	if kind == kindSlice {
+		panic("slices as direct array elements are not supported")
+	}
+
+	if structure, ok := elementType.(packedStruct); ok && structure.variable {
+		panic(fmt.Sprintf("variable size struct %s as array element is not supported", structure.name))
+	}
+
+	if structure, ok := elementType.(packedStruct); ok && structure.prepared {
+		panic(fmt.Sprintf("struct %s with synchronized fields as array element is not supported", structure.name))
+	}
+
+	if kind == kindUnion {
+		panic("unions as direct array elements are not supported")
+	}
+
+	if kind == kindChecksum {
+		panic("checksums as array elements are not supported")
+	}
+
+	if kind == kindVariable {
+		panic("variable size converters as array elements are not supported")
+	}
+
+	if structure, ok := elementType.(packedStruct); ok && structure.checksummed {
+		panic(fmt.Sprintf("struct %s with checksums as array element is not supported", structure.name))
+	}
+
 	if kind == kindConverter {
 		hash := createConverterHash(elementType)
 
@@ -49,9 +82,17 @@ func Array(length int, elementType any) packedArray {
 		}
 	}
 
-	recieverType := fmt.Sprintf("[%d]%s", length, getArrayRecieverType(elementType))
 	elementSize := elementType.(interface{ Size() int }).Size()
 
+	return kind, elementType, elementSize
+}
+
+func Array(length int, elementType any) packedArray {
+
+	kind, elementType, elementSize := validateElementType(elementType)
+
+	recieverType := fmt.Sprintf("[%d]%s", length, getArrayRecieverType(elementType))
+
 	return packedArray{
 		Length:       length,
 		Element:      elementType,
@@ -61,6 +102,12 @@ func Array(length int, elementType any) packedArray {
 	}
 }
 
+func FixedBytes(length int) packedArray {
+	array := Array(length, Uint8)
+	array.recieverType = fmt.Sprintf("[%d]byte", length)
+	return array
+}
+
 func (p *packedProperty) writeArrayElement(buffer *bytes.Buffer, structure *packedStruct, functionName, recieverPrefix string, offsetVariable string, depth int) {
 	endian := "LittleEndian"
 
@@ -78,7 +125,7 @@ func (p *packedProperty) writeArrayElement(buffer *bytes.Buffer, structure *pack
 		}
 
 	case kindConverter:
-		fmt.Fprintf(buffer, "%s.%s%s(&%s, bytes, %s)\n", getConverterName(p.converter.hash), functionName, endian, reciever, offsetVariable)
+		writeConverterCall(buffer, structure, *p.converter, functionName+endian, reciever, reciever, offsetVariable)
 		fmt.Fprintf(buffer, "%s += %d\n", offsetVariable, p.size)
 
 	case kindConverterCast:
@@ -94,14 +141,22 @@ func (p *packedProperty) writeArrayElement(buffer *bytes.Buffer, structure *pack
 		fmt.Fprintf(buffer, "%s.%s%s(bytes, %s)\n", reciever, functionName, endian, offsetVariable)
 		fmt.Fprintf(buffer, "%s += %d\n", offsetVariable, p.size)
 
+	case kindPadding:
+		p.packed.(packedPadding).write(buffer, structure, recieverPrefix, functionName, offsetVariable)
+		fmt.Fprintf(buffer, "%s += %d\n", offsetVariable, p.size)
+
+	case kindConst:
+		p.packed.(packedConst).write(buffer, structure, reciever, functionName, p.littleEndian, offsetVariable)
+		fmt.Fprintf(buffer, "%s += %d\n", offsetVariable, p.size)
+
 	case kindBitFieldGroup:
 		group := p.packed.(packedBitFieldGroup)
 
 		switch functionName {
 		case "ToBytes":
-			group.writeToBytes(buffer, reciever, p.littleEndian, offsetVariable)
+			group.writeToBytes(buffer, structure, reciever, p.littleEndian, offsetVariable)
 		case "FromBytes":
-			group.writeFromBytes(buffer, reciever, p.littleEndian, offsetVariable)
+			group.writeFromBytes(buffer, structure, reciever, p.littleEndian, offsetVariable)
 		}
 
 		fmt.Fprintf(buffer, "%s += %d\n", offsetVariable, group.size)
@@ -112,12 +167,92 @@ func (p *packedProperty) writeArrayElement(buffer *bytes.Buffer, structure *pack
 }
 
 func (a packedArray) write(buffer *bytes.Buffer, structure *packedStruct, recieverVariable string, functionName string, littleEndian bool, offsetVariable string, depth int) {
+	writeElements(buffer, structure, a.Element, a.ElementKind, a.ElementSize, fmt.Sprintf("%d", a.Length), recieverVariable, functionName, littleEndian, offsetVariable, depth)
+
+	if functionName == "FromBytes" && a.fixedString != nil {
+		fmt.Fprintf(buffer, "if err := %s.Verify(%s[:], 0); err != nil {\n", getConverterName(a.fixedString.converter.hash), recieverVariable)
+		fmt.Fprintf(buffer, "return 0, &packed.FieldError{Path: %q, Err: err}\n", fieldPath(structure, recieverVariable))
+		fmt.Fprintf(buffer, "}\n")
+	}
+}
+
+func byteElementType(element any, elementKind kind) reflect.Type {
+
+	if elementKind != kindConverter {
+		return nil
+	}
+
+	switch element.(type) {
+	case Uint8Converter, *Uint8Converter:
+		return reflect.TypeFor[uint8]()
+	case Int8Converter, *Int8Converter:
+		return reflect.TypeFor[int8]()
+	}
+
+	return nil
+}
+
+func writeByteElements(buffer *bytes.Buffer, elementType reflect.Type, length string, recieverVariable string, functionName string, offsetVariable string, depth int) {
+
+	if elementType.Kind() == reflect.Uint8 {
+
+		switch functionName {
+		case "ToBytes":
+			fmt.Fprintf(buffer, "copy(bytes[%s:], %s[:])\n", offsetVariable, recieverVariable)
+		case "FromBytes":
+			fmt.Fprintf(buffer, "copy(%s[:], bytes[%s:])\n", recieverVariable, offsetVariable)
+		}
+
+		fmt.Fprintf(buffer, "%s += %s\n", offsetVariable, length)
+		return
+	}
+
+	indexVariable := fmt.Sprintf("i%d", depth)
+
+	fmt.Fprintf(buffer, "for %s := 0; %s < %s; %s++ {\n", indexVariable, indexVariable, length, indexVariable)
+
+	switch functionName {
+	case "ToBytes":
+		fmt.Fprintf(buffer, "bytes[%s+%s] = byte(%s[%s])\n", offsetVariable, indexVariable, recieverVariable, indexVariable)
+	case "FromBytes":
+		fmt.Fprintf(buffer, "%s[%s] = %s(bytes[%s+%s])\n", recieverVariable, indexVariable, elementType, offsetVariable, indexVariable)
+	}
+
+	fmt.Fprintf(buffer, "}\n")
+	fmt.Fprintf(buffer, "%s += %s\n", offsetVariable, length)
+}
+
+func writeMemoryElements(buffer *bytes.Buffer, elementSize int, length string, recieverVariable string, functionName string, offsetVariable string) {
+
+	memory := fmt.Sprintf("unsafe.Slice((*byte)(unsafe.Pointer(unsafe.SliceData(%s[:]))), %s*%d)", recieverVariable, length, elementSize)
+
+	switch functionName {
+	case "ToBytes":
+		fmt.Fprintf(buffer, "copy(bytes[%s:], %s)\n", offsetVariable, memory)
+	case "FromBytes":
+		fmt.Fprintf(buffer, "copy(%s, bytes[%s:])\n", memory, offsetVariable)
+	}
+
+	fmt.Fprintf(buffer, "%s += %s*%d\n", offsetVariable, length, elementSize)
+}
+
+func writeElements(buffer *bytes.Buffer, structure *packedStruct, element any, elementKind kind, elementSize int, length string, recieverVariable string, functionName string, littleEndian bool, offsetVariable string, depth int) {
+
+	if elementType := byteElementType(element, elementKind); elementType != nil {
+		writeByteElements(buffer, elementType, length, recieverVariable, functionName, offsetVariable, depth)
+		return
+	}
+
+	if pixel, ok := toPointer(element).(*PixelConverter); ok && elementKind == kindConverter && pixel.matchesMemory(littleEndian) {
+		writeMemoryElements(buffer, elementSize, length, recieverVariable, functionName, offsetVariable)
+		return
+	}
 
 	indexVariable := fmt.Sprintf("i%d", depth)
 
 	recieverVariable = fmt.Sprintf("%s[%s]", recieverVariable, indexVariable)
 
-	fmt.Fprintf(buffer, "for %s := 0; %s < %d; %s++ {\n", indexVariable, indexVariable, a.Length, indexVariable)
+	fmt.Fprintf(buffer, "for %s := 0; %s < %s; %s++ {\n", indexVariable, indexVariable, length, indexVariable)
 
 	endian := "LittleEndian"
 
@@ -125,30 +260,29 @@ func (a packedArray) write(buffer *bytes.Buffer, structure *packedStruct, reciev
 		endian = "BigEndian"
 	}
 
-	switch a.ElementKind {
+	switch elementKind {
 
 	case kindStruct:
-		childStruct := a.Element.(packedStruct)
+		childStruct := element.(packedStruct)
 		for _, property := range childStruct.properties {
 			property.writeArrayElement(buffer, structure, functionName, recieverVariable, offsetVariable, depth)
 		}
 
 	case kindConverter:
-		hash := createConverterHash(a.Element)
-		fmt.Fprintf(buffer, "%s.%s%s(&%s, bytes, %s)\n", getConverterName(hash.hash), functionName, endian, recieverVariable, offsetVariable)
-		fmt.Fprintf(buffer, "%s += %d\n", offsetVariable, a.ElementSize)
+		writeConverterCall(buffer, structure, createConverterHash(element), functionName+endian, recieverVariable, recieverVariable, offsetVariable)
+		fmt.Fprintf(buffer, "%s += %d\n", offsetVariable, elementSize)
 
 	case kindConverterCast:
-		cast := a.Element.(converterCast)
+		cast := element.(converterCast)
 		cast.Write(buffer, structure, recieverVariable, functionName, littleEndian, offsetVariable)
 		fmt.Fprintf(buffer, "%s += %d\n", offsetVariable, cast.size)
 
 	case kindArray:
-		a.Element.(packedArray).write(buffer, structure, recieverVariable, functionName, littleEndian, offsetVariable, depth+1)
+		element.(packedArray).write(buffer, structure, recieverVariable, functionName, littleEndian, offsetVariable, depth+1)
 
 	case kindType:
 		fmt.Fprintf(buffer, "%s.%s%s(bytes, %s)\n", recieverVariable, functionName, endian, offsetVariable)
-		fmt.Fprintf(buffer, "%s += %d\n", offsetVariable, a.ElementSize)
+		fmt.Fprintf(buffer, "%s += %d\n", offsetVariable, elementSize)
 
 	default:
 		panic("invalid property kind")
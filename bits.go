@@ -42,6 +42,8 @@ const (
 	bitFieldKindBoolean
 	bitFieldKindBitsType
 	bitFieldKindBitsConverter
+	bitFieldKindReserved
+	bitFieldKindEnum
 )
 
 type packedBitField struct {
@@ -51,6 +53,23 @@ type packedBitField struct {
 	bitFieldKind         bitFieldKind
 	bitsTargetReflection reflect.Type
 	converter            *converterHash
+	fill                 uint64
+	verify               bool
+	enum                 *packedEnum
+	strict               bool
+	flags                *packedFlags
+}
+
+func (p packedBitField) typeName() string {
+
+	switch {
+	case p.enum != nil:
+		return p.enum.name
+	case p.flags != nil:
+		return p.flags.name
+	}
+
+	return p.reflection.String()
 }
 
 func (p packedBitField) signed() bool {
@@ -94,6 +113,18 @@ func Bits[Interger constraints.Integer](bits int, bitsTarget ...any) packedBitFi
 		return field
 	}
 
+	if cast, ok := bitsTarget[0].(converterCast); ok && cast.enum != nil {
+
+		if !cast.enum.fits(bits) {
+			panic(fmt.Sprintf("enum %s does not fit in %d bits", cast.enum.name, bits))
+		}
+
+		field.bitFieldKind = bitFieldKindEnum
+		field.enum = cast.enum
+		field.strict = cast.strict
+		return field
+	}
+
 	target := toPointer(bitsTarget[0])
 
 	if _, ok := target.(BitsTypeInterface[Interger]); ok {
@@ -108,6 +139,10 @@ func Bits[Interger constraints.Integer](bits int, bitsTarget ...any) packedBitFi
 		panic("invalid type for bitfield")
 	}
 
+	if width, ok := target.(interface{ bitWidth() int }); ok && width.bitWidth() > bits {
+		panic(fmt.Sprintf("bits converter needs %d bits but the bit field has %d", width.bitWidth(), bits))
+	}
+
 	field.bitFieldKind = bitFieldKindBitsConverter
 	field.bitsTargetReflection = reciever
 
@@ -158,6 +193,7 @@ func (g packedBitFieldGroup) computeOffsets(littleEndian bool) []fieldOffset {
 
 func (g packedBitFieldGroup) writeToBytes(
 	buffer *bytes.Buffer,
+	structure *packedStruct,
 	receiverVariable string,
 	littleEndian bool,
 	offset string,
@@ -170,6 +206,13 @@ func (g packedBitFieldGroup) writeToBytes(
 		bitOffset := fieldOffset.bitOffset
 		receiver := receiverVariable + field.packedProperty.name
 
+		if field.bitFieldKind == bitFieldKindReserved {
+			if field.fill != 0 {
+				fmt.Fprintf(buffer, "b%d |= 0x%X\n", g.groupIndex, field.fill<<bitOffset)
+			}
+			continue
+		}
+
 		if field.bitFieldKind == bitFieldKindBoolean {
 			fmt.Fprintf(
 				buffer,
@@ -181,13 +224,27 @@ func (g packedBitFieldGroup) writeToBytes(
 			continue
 		}
 
+		scoped := false
+
 		switch field.bitFieldKind {
 
 		case bitFieldKindBitsType:
 			receiver += ".Integer()"
 
 		case bitFieldKindBitsConverter:
-			receiver = fmt.Sprintf("%s.Integer(&%s)", getConverterName(field.converter.hash), receiver)
+			call := fmt.Sprintf("%s.Integer(&%s)", getConverterName(field.converter.hash), receiver)
+
+			if converterReturnsError(field.converter.instance, "Integer") {
+				fmt.Fprintf(buffer, "{\n")
+				fmt.Fprintf(buffer, "value, err := %s\n", call)
+				fmt.Fprintf(buffer, "if err != nil {\n")
+				fmt.Fprintf(buffer, "return 0, &packed.FieldError{Path: %s, Err: err}\n", fieldPathExpression(structure, receiver))
+				fmt.Fprintf(buffer, "}\n")
+				call = "value"
+				scoped = true
+			}
+
+			receiver = call
 		}
 
 		mask := (uint64(1) << field.bitSize) - 1
@@ -209,6 +266,10 @@ func (g packedBitFieldGroup) writeToBytes(
 				bitOffset,
 			)
 		}
+
+		if scoped {
+			fmt.Fprintf(buffer, "}\n")
+		}
 	}
 
 	for i := 0; i < g.size; i++ {
@@ -229,6 +290,7 @@ func (g packedBitFieldGroup) writeToBytes(
 
 func (g packedBitFieldGroup) writeFromBytes(
 	buffer *bytes.Buffer,
+	structure *packedStruct,
 	receiverVariable string,
 	littleEndian bool,
 	offset string,
@@ -259,6 +321,15 @@ func (g packedBitFieldGroup) writeFromBytes(
 
 		mask := (uint64(1) << field.bitSize) - 1
 
+		if field.bitFieldKind == bitFieldKindReserved {
+			if field.verify {
+				fmt.Fprintf(buffer, "if (b%d>>%d)&0x%X != 0x%X {\n", g.groupIndex, bitOffset, mask, field.fill)
+				fmt.Fprintf(buffer, "return 0, &packed.FieldError{Path: %q, Err: packed.ErrInvalidPadding}\n", fieldPath(structure, receiverVariable))
+				fmt.Fprintf(buffer, "}\n")
+			}
+			continue
+		}
+
 		if field.bitFieldKind == bitFieldKindBoolean {
 			fmt.Fprintf(
 				buffer,
@@ -271,23 +342,31 @@ func (g packedBitFieldGroup) writeFromBytes(
 			continue
 		}
 
+		fallible := field.bitFieldKind == bitFieldKindBitsConverter && converterReturnsError(field.converter.instance, "Set")
+
 		switch field.bitFieldKind {
 
 		case bitFieldKindBitsType:
 			fmt.Fprintf(buffer, "%s.Set(", receiver)
 
 		case bitFieldKindBitsConverter:
+			if fallible {
+				fmt.Fprintf(buffer, "if err := ")
+			}
+
 			fmt.Fprintf(buffer, "%s.Set(&%s, ", getConverterName(field.converter.hash), receiver)
 
 		default:
 			fmt.Fprintf(buffer, "%s = ", receiver)
 		}
 
+		typeName := field.typeName()
+
 		if field.signed() {
 			fmt.Fprintf(
 				buffer,
 				"%s((( (b%d >> %d) & 0x%X ) ^ (1 << %d)) - (1 << %d))",
-				field.reflection,
+				typeName,
 				g.groupIndex,
 				bitOffset,
 				mask,
@@ -298,7 +377,7 @@ func (g packedBitFieldGroup) writeFromBytes(
 			fmt.Fprintf(
 				buffer,
 				"%s(uint64((b%d >> %d) & 0x%X))",
-				field.reflection,
+				typeName,
 				g.groupIndex,
 				bitOffset,
 				mask,
@@ -308,10 +387,22 @@ func (g packedBitFieldGroup) writeFromBytes(
 		switch field.bitFieldKind {
 
 		case bitFieldKindBitsType, bitFieldKindBitsConverter:
-			fmt.Fprintf(buffer, ")\n")
+			fmt.Fprintf(buffer, ")")
+
+			if fallible {
+				fmt.Fprintf(buffer, "; err != nil {\n")
+				fmt.Fprintf(buffer, "return 0, &packed.FieldError{Path: %s, Err: err}\n", fieldPathExpression(structure, receiver))
+				fmt.Fprintf(buffer, "}")
+			}
+
+			fmt.Fprintf(buffer, "\n")
 
 		default:
 			fmt.Fprintf(buffer, "\n")
 		}
+
+		if field.strict {
+			field.enum.writeStrictCheck(buffer, structure, receiver)
+		}
 	}
 }
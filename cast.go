@@ -11,17 +11,31 @@ type converterCast struct {
 	reciever  reflect.Type
 	target    reflect.Type
 	size      int
+	enum      *packedEnum
+	strict    bool
 }
 
 func (c converterCast) Size() int {
 	return c.size
 }
 
+func (c converterCast) targetName() string {
+
+	if c.enum != nil {
+		return c.enum.name
+	}
+
+	return c.target.String()
+}
+
 func Cast[T any](converter any) converterCast {
 
 	var value T
 
-	target := reflect.TypeOf(value)
+	return createCast(converter, reflect.TypeOf(value))
+}
+
+func createCast(converter any, target reflect.Type) converterCast {
 
 	converter = toPointer(converter)
 
@@ -68,11 +82,15 @@ func (c converterCast) Write(buffer *bytes.Buffer, structure *packedStruct, reci
 	switch functionName {
 	case "ToBytes":
 		fmt.Fprintf(buffer, "r%d = %s(%s)\n", recieverIndex, c.reciever, recieverVariable)
-		fmt.Fprintf(buffer, "%s.ToBytes%s(&r%d, bytes, %s)\n", getConverterName(c.converter.hash), endian, recieverIndex, offsetVariable)
+		writeConverterCall(buffer, structure, c.converter, "ToBytes"+endian, fmt.Sprintf("r%d", recieverIndex), recieverVariable, offsetVariable)
 
 	case "FromBytes":
-		fmt.Fprintf(buffer, "%s.FromBytes%s(&r%d, bytes, %s)\n", getConverterName(c.converter.hash), endian, recieverIndex, offsetVariable)
-		fmt.Fprintf(buffer, "%s = %s(r%d)\n", recieverVariable, c.target, recieverIndex)
+		writeConverterCall(buffer, structure, c.converter, "FromBytes"+endian, fmt.Sprintf("r%d", recieverIndex), recieverVariable, offsetVariable)
+		fmt.Fprintf(buffer, "%s = %s(r%d)\n", recieverVariable, c.targetName(), recieverIndex)
+
+		if c.strict {
+			c.enum.writeStrictCheck(buffer, structure, recieverVariable)
+		}
 
 	default:
 		panic("invalid function name")
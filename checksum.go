@@ -0,0 +1,403 @@
+package packed
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"math/bits"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+type ChecksumInterface interface {
+	Size() int
+	Checksum(bytes []byte) uint64
+}
+
+var (
+	CRC8        = CRC(8, 0x07, 0x00, false, false, 0x00)
+	CRC16CCITT  = CRC(16, 0x1021, 0xFFFF, false, false, 0x0000)
+	CRC16XModem = CRC(16, 0x1021, 0x0000, false, false, 0x0000)
+	CRC16Kermit = CRC(16, 0x1021, 0x0000, true, true, 0x0000)
+	CRC16Modbus = CRC(16, 0x8005, 0xFFFF, true, true, 0x0000)
+	CRC32       = CRC(32, 0x04C11DB7, 0xFFFFFFFF, true, true, 0xFFFFFFFF)
+	CRC32C      = CRC(32, 0x1EDC6F41, 0xFFFFFFFF, true, true, 0xFFFFFFFF)
+	CRC64XZ     = CRC(64, 0x42F0E1EBA9EA3693, math.MaxUint64, true, true, math.MaxUint64)
+	Sum8        = SumChecksum{Width: 1}
+	Sum16       = SumChecksum{Width: 2}
+	Xor8        = XorChecksum{}
+)
+
+func CRC(width int, polynomial, init uint64, reflectIn, reflectOut bool, xorOut uint64) CRCChecksum {
+
+	if width < 8 || width > 64 {
+		panic(fmt.Sprintf("crc width must be between 8 and 64 bits, got %d", width))
+	}
+
+	checksum := CRCChecksum{
+		Width:      width,
+		Polynomial: polynomial,
+		Init:       init,
+		ReflectIn:  reflectIn,
+		ReflectOut: reflectOut,
+		XorOut:     xorOut,
+	}
+
+	mask := checksum.mask()
+
+	if polynomial&^mask != 0 || init&^mask != 0 || xorOut&^mask != 0 {
+		panic(fmt.Sprintf("crc parameters must fit in %d bits", width))
+	}
+
+	return checksum
+}
+
+type CRCChecksum struct {
+	Width      int    `packed_hash_field:"width"`
+	Polynomial uint64 `packed_hash_field:"polynomial"`
+	Init       uint64 `packed_hash_field:"init"`
+	ReflectIn  bool   `packed_hash_field:"reflect_in"`
+	ReflectOut bool   `packed_hash_field:"reflect_out"`
+	XorOut     uint64 `packed_hash_field:"xor_out"`
+}
+
+var crcTables sync.Map
+
+func (c *CRCChecksum) InitializeConverterFields() map[string]string {
+	return map[string]string{
+		"Width":      fmt.Sprintf("%v", c.Width),
+		"Polynomial": fmt.Sprintf("0x%X", c.Polynomial),
+		"Init":       fmt.Sprintf("0x%X", c.Init),
+		"ReflectIn":  fmt.Sprintf("%v", c.ReflectIn),
+		"ReflectOut": fmt.Sprintf("%v", c.ReflectOut),
+		"XorOut":     fmt.Sprintf("0x%X", c.XorOut),
+	}
+}
+
+func (c *CRCChecksum) Size() int { return (c.Width + 7) / 8 }
+
+func (c *CRCChecksum) mask() uint64 {
+	if c.Width == 64 {
+		return math.MaxUint64
+	}
+	return uint64(1)<<c.Width - 1
+}
+
+func reflectBits(value uint64, width int) uint64 {
+	return bits.Reverse64(value) >> (64 - width)
+}
+
+func (c *CRCChecksum) table() *[256]uint64 {
+
+	if table, ok := crcTables.Load(*c); ok {
+		return table.(*[256]uint64)
+	}
+
+	table := &[256]uint64{}
+
+	if c.ReflectIn {
+		polynomial := reflectBits(c.Polynomial, c.Width)
+
+		for i := range table {
+			crc := uint64(i)
+
+			for range 8 {
+				if crc&1 != 0 {
+					crc = crc>>1 ^ polynomial
+				} else {
+					crc >>= 1
+				}
+			}
+
+			table[i] = crc
+		}
+	} else {
+		top := uint64(1) << (c.Width - 1)
+
+		for i := range table {
+			crc := uint64(i) << (c.Width - 8)
+
+			for range 8 {
+				if crc&top != 0 {
+					crc = crc<<1 ^ c.Polynomial
+				} else {
+					crc <<= 1
+				}
+			}
+
+			table[i] = crc & c.mask()
+		}
+	}
+
+	actual, _ := crcTables.LoadOrStore(*c, table)
+	return actual.(*[256]uint64)
+}
+
+func (c *CRCChecksum) Checksum(bytes []byte) uint64 {
+
+	table := c.table()
+	crc := c.Init
+
+	if c.ReflectIn {
+		crc = reflectBits(crc, c.Width)
+
+		for _, value := range bytes {
+			crc = table[byte(crc)^value] ^ crc>>8
+		}
+	} else {
+		shift := c.Width - 8
+
+		for _, value := range bytes {
+			crc = table[byte(crc>>shift)^value] ^ crc<<8
+		}
+	}
+
+	if c.ReflectIn != c.ReflectOut {
+		crc = reflectBits(crc, c.Width)
+	}
+
+	return (crc ^ c.XorOut) & c.mask()
+}
+
+type SumChecksum struct {
+	Width int `packed_hash_field:"width"`
+}
+
+func (s *SumChecksum) InitializeConverterFields() map[string]string {
+	return map[string]string{
+		"Width": fmt.Sprintf("%v", s.Width),
+	}
+}
+
+func (s *SumChecksum) Size() int { return s.Width }
+
+func (s *SumChecksum) Checksum(bytes []byte) uint64 {
+
+	sum := uint64(0)
+
+	for _, value := range bytes {
+		sum += uint64(value)
+	}
+
+	if s.Width >= 8 {
+		return sum
+	}
+
+	return sum & (uint64(1)<<(8*s.Width) - 1)
+}
+
+type XorChecksum struct{}
+
+func (XorChecksum) Size() int { return 1 }
+
+func (XorChecksum) Checksum(bytes []byte) uint64 {
+
+	result := byte(0)
+
+	for _, value := range bytes {
+		result ^= value
+	}
+
+	return uint64(result)
+}
+
+type packedChecksum struct {
+	algorithm    converterHash
+	from         string
+	to           string
+	fromIndex    int
+	toIndex      int
+	size         int
+	recieverType reflect.Type
+}
+
+func (c packedChecksum) Size() int { return c.size }
+
+func Checksum(algorithm any, from string, to string) packedChecksum {
+
+	checksum, ok := toPointer(algorithm).(ChecksumInterface)
+
+	if !ok {
+		panic(fmt.Sprintf("invalid checksum algorithm: %T", algorithm))
+	}
+
+	size := checksum.Size()
+
+	if size < 1 || size > 8 {
+		panic(fmt.Sprintf("checksum size must be between 1 and 8 bytes, got %d", size))
+	}
+
+	if strings.Contains(from, ".") || strings.Contains(to, ".") {
+		panic("checksum ranges must name properties of the same struct")
+	}
+
+	reciever := reflect.TypeOf(uint64(0))
+
+	switch {
+	case size == 1:
+		reciever = reflect.TypeOf(uint8(0))
+	case size == 2:
+		reciever = reflect.TypeOf(uint16(0))
+	case size <= 4:
+		reciever = reflect.TypeOf(uint32(0))
+	}
+
+	hash := createConverterHash(checksum)
+
+	if _, exists := converters[hash.hash]; !exists {
+		converters[hash.hash] = hash
+	}
+
+	imported[reflect.TypeOf(checksum).Elem().PkgPath()] = true
+
+	return packedChecksum{
+		algorithm:    hash,
+		from:         from,
+		to:           to,
+		size:         size,
+		recieverType: reciever,
+	}
+}
+
+func checksumRangeIndex(properties []packedProperty, name string) int {
+
+	if index, ok := propertyIndex(properties, name); ok {
+		return index
+	}
+
+	panic(fmt.Sprintf("checksum range field %s does not exist", name))
+}
+
+func (c *packedChecksum) resolveRange(properties []packedProperty, index int) {
+
+	c.fromIndex = 0
+	c.toIndex = index - 1
+
+	if c.from != "" {
+		c.fromIndex = checksumRangeIndex(properties, c.from)
+	}
+
+	if c.to != "" {
+		c.toIndex = checksumRangeIndex(properties, c.to)
+	}
+
+	if c.fromIndex > c.toIndex {
+		panic(fmt.Sprintf("checksum %s covers an empty range", properties[index].name))
+	}
+
+	if c.fromIndex <= index && index <= c.toIndex {
+		panic(fmt.Sprintf("checksum %s cannot cover itself", properties[index].name))
+	}
+}
+
+func checksumVariable(structure *packedStruct, reciever string) string {
+	return strings.ReplaceAll(fieldPath(structure, reciever), ".", "")
+}
+
+func (p *packedStruct) writeChecksumMarkers(buffer *bytes.Buffer, structure *packedStruct, functionName, recieverPrefix string, index int, after bool, offset *propertyOffset) {
+
+	for i, property := range p.properties {
+
+		if property.kind != kindChecksum {
+			continue
+		}
+
+		checksum := property.packed.(packedChecksum)
+		variable := checksumVariable(structure, recieverPrefix+"."+property.name)
+
+		switch {
+
+		case after && checksum.toIndex == index:
+			fmt.Fprintf(buffer, "checksumEnd%s := index + %d\n", variable, offset.constant)
+
+		case !after && checksum.fromIndex == index:
+			fmt.Fprintf(buffer, "checksumStart%s := index + %d\n", variable, offset.constant)
+		}
+
+		if !after && i == index && functionName == "ToBytes" {
+			fmt.Fprintf(buffer, "checksumIndex%s := index + %d\n", variable, offset.constant)
+		}
+	}
+}
+
+func (c packedChecksum) write(buffer *bytes.Buffer, reciever string, functionName string, littleEndian bool, offset string) {
+
+	if functionName != "FromBytes" {
+		return
+	}
+
+	parts := []string{}
+
+	for i := 0; i < c.size; i++ {
+
+		shift := 8 * i
+
+		if !littleEndian {
+			shift = 8 * (c.size - 1 - i)
+		}
+
+		part := fmt.Sprintf("%s(bytes[%s+%d])", c.recieverType, offset, i)
+
+		if shift > 0 {
+			part += fmt.Sprintf("<<%d", shift)
+		}
+
+		parts = append(parts, part)
+	}
+
+	fmt.Fprintf(buffer, "%s = %s\n", reciever, strings.Join(parts, " | "))
+}
+
+func (p *packedStruct) writeChecksums(buffer *bytes.Buffer, structure *packedStruct, functionName, recieverPrefix string) {
+
+	for _, property := range p.properties {
+
+		reciever := recieverPrefix + "." + property.name
+
+		switch property.kind {
+
+		case kindStruct:
+			child := property.packed.(packedStruct)
+			child.writeChecksums(buffer, structure, functionName, reciever)
+
+		case kindChecksum:
+			property.packed.(packedChecksum).writeResult(buffer, structure, reciever, functionName, property.littleEndian)
+		}
+	}
+}
+
+func (c packedChecksum) writeResult(buffer *bytes.Buffer, structure *packedStruct, reciever string, functionName string, littleEndian bool) {
+
+	variable := checksumVariable(structure, reciever)
+	result := fmt.Sprintf("%s(%s.Checksum(bytes[checksumStart%s:checksumEnd%s]))", c.recieverType, getConverterName(c.algorithm.hash), variable, variable)
+
+	switch functionName {
+
+	case "ToBytes":
+		fmt.Fprintf(buffer, "%s = %s\n", reciever, result)
+
+		for i := 0; i < c.size; i++ {
+
+			shift := 8 * i
+
+			if !littleEndian {
+				shift = 8 * (c.size - 1 - i)
+			}
+
+			if shift == 0 {
+				fmt.Fprintf(buffer, "bytes[checksumIndex%s+%d] = byte(%s)\n", variable, i, reciever)
+			} else {
+				fmt.Fprintf(buffer, "bytes[checksumIndex%s+%d] = byte(%s >> %d)\n", variable, i, reciever, shift)
+			}
+		}
+
+	case "FromBytes":
+		fmt.Fprintf(buffer, "if checksum := %s; checksum != %s {\n", result, reciever)
+		fmt.Fprintf(buffer, "return 0, &packed.FieldError{Path: %q, Err: fmt.Errorf(\"%%w: expected 0x%%X, got 0x%%X\", packed.ErrChecksumMismatch, checksum, %s)}\n", fieldPath(structure, reciever), reciever)
+		fmt.Fprintf(buffer, "}\n")
+
+	default:
+		panic("invalid function name")
+	}
+}
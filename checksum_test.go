@@ -0,0 +1,38 @@
+package packed
+
+import (
+	"testing"
+)
+
+func TestChecksumCheckValues(t *testing.T) {
+
+	check := []byte("123456789")
+
+	tests := []struct {
+		name      string
+		algorithm ChecksumInterface
+		expected  uint64
+	}{
+		{"CRC-8", &CRC8, 0xF4},
+		{"CRC-16/CCITT-FALSE", &CRC16CCITT, 0x29B1},
+		{"CRC-16/XMODEM", &CRC16XModem, 0x31C3},
+		{"CRC-16/KERMIT", &CRC16Kermit, 0x2189},
+		{"CRC-16/MODBUS", &CRC16Modbus, 0x4B37},
+		{"CRC-32", &CRC32, 0xCBF43926},
+		{"CRC-32C", &CRC32C, 0xE3069283},
+		{"CRC-64/XZ", &CRC64XZ, 0x995DC9BBDF1939FA},
+		{"CRC-16/GENIBUS", &CRCChecksum{Width: 16, Polynomial: 0x1021, Init: 0xFFFF, XorOut: 0xFFFF}, 0xD64E},
+		{"CRC-32/BZIP2", &CRCChecksum{Width: 32, Polynomial: 0x04C11DB7, Init: 0xFFFFFFFF, XorOut: 0xFFFFFFFF}, 0xFC891918},
+		{"CRC-24/OPENPGP", &CRCChecksum{Width: 24, Polynomial: 0x864CFB, Init: 0xB704CE}, 0x21CF02},
+		{"CRC-12/UMTS", &CRCChecksum{Width: 12, Polynomial: 0x80F, ReflectOut: true}, 0xDAF},
+		{"Sum8", &Sum8, 0xDD},
+		{"Sum16", &Sum16, 0x01DD},
+		{"Xor8", &Xor8, 0x31},
+	}
+
+	for _, test := range tests {
+		if result := test.algorithm.Checksum(check); result != test.expected {
+			t.Errorf("%s: expected 0x%X, got 0x%X", test.name, test.expected, result)
+		}
+	}
+}
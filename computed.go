@@ -0,0 +1,284 @@
+package packed
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"slices"
+)
+
+type computedKind int
+
+const (
+	computedNone computedKind = iota
+	computedSize
+	computedOffset
+	computedCount
+)
+
+type computedExpression struct {
+	kind        computedKind
+	field       string
+	verify      bool
+	properties  []packedProperty
+	size        int
+	elementType string
+	typeName    string
+	maximum     uint64
+}
+
+func SizeOf(field string) computedExpression {
+	return computedExpression{kind: computedSize, field: field}
+}
+
+func OffsetOf(field string) computedExpression {
+
+	if field == "" {
+		panic("offset expressions must name a field")
+	}
+
+	return computedExpression{kind: computedOffset, field: field}
+}
+
+func CountOf(arrayField string) computedExpression {
+
+	if arrayField == "" {
+		panic("count expressions must name an array field")
+	}
+
+	return computedExpression{kind: computedCount, field: arrayField}
+}
+
+func Computed(expression computedExpression) fieldOption {
+	return func(definition *packedProperty) {
+
+		_, name, maximum, ok := integerProperty(*definition)
+
+		if !ok {
+			panic("computed fields must be integers")
+		}
+
+		expression.typeName = name
+		expression.maximum = maximum
+		definition.computed = expression
+	}
+}
+
+func propertyIndex(properties []packedProperty, name string) (int, bool) {
+
+	for i, property := range properties {
+
+		if property.kind == kindBitFieldGroup {
+			for _, field := range property.packed.(packedBitFieldGroup).fields {
+				if field.packedProperty.name == name {
+					return i, true
+				}
+			}
+
+			continue
+		}
+
+		if property.name == name {
+			return i, true
+		}
+	}
+
+	return 0, false
+}
+
+func (e *computedExpression) resolve(properties []packedProperty, name string) {
+
+	index := len(properties)
+
+	if e.field != "" {
+		var ok bool
+
+		if index, ok = propertyIndex(properties, e.field); !ok {
+			panic(fmt.Sprintf("computed field %s refers to %s which does not exist", name, e.field))
+		}
+	}
+
+	switch e.kind {
+
+	case computedSize:
+		switch {
+		case e.field == "":
+			e.properties = slices.Clone(properties)
+		case properties[index].kind == kindBitFieldGroup:
+			panic(fmt.Sprintf("computed field %s cannot take the size of bit field %s", name, e.field))
+		default:
+			e.properties = slices.Clone(properties[index : index+1])
+		}
+
+	case computedOffset:
+		e.properties = slices.Clone(properties[:index])
+
+	case computedCount:
+		property := properties[index]
+
+		if property.kind != kindArray {
+			panic(fmt.Sprintf("computed field %s counts %s which is not an array", name, e.field))
+		}
+
+		array := property.packed.(packedArray)
+
+		if e.maximum != math.MaxUint64 && uint64(array.Length) > e.maximum {
+			panic(fmt.Sprintf("computed field %s cannot hold the length of %s", name, e.field))
+		}
+
+		e.elementType = getArrayRecieverType(array.Element)
+		return
+	}
+
+	e.size = 0
+
+	for _, property := range e.properties {
+		if property.when == "" {
+			e.size += property.size
+		}
+	}
+
+	if !e.isVariable() && uint64(e.size) > e.maximum {
+		panic(fmt.Sprintf("computed field %s cannot hold the value %d", name, e.size))
+	}
+}
+
+func (e computedExpression) String() string {
+
+	switch e.kind {
+
+	case computedSize:
+		if e.field == "" {
+			return "size of struct"
+		}
+		return "size of " + e.field
+
+	case computedOffset:
+		return "offset of " + e.field
+
+	case computedCount:
+		return "count of " + e.field
+
+	default:
+		return ""
+	}
+}
+
+func (e computedExpression) isVariable() bool {
+
+	for _, property := range e.properties {
+		if property.variable {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (e computedExpression) writeValue(buffer *bytes.Buffer, recieverPrefix string) string {
+
+	switch e.kind {
+
+	case computedCount:
+		fmt.Fprintf(buffer, "count := 0\n")
+		fmt.Fprintf(buffer, "var zero %s\n", e.elementType)
+		fmt.Fprintf(buffer, "for _, element := range %s.%s {\n", recieverPrefix, e.field)
+		fmt.Fprintf(buffer, "if element != zero {\n")
+		fmt.Fprintf(buffer, "count++\n")
+		fmt.Fprintf(buffer, "}\n")
+		fmt.Fprintf(buffer, "}\n")
+		return "count"
+
+	default:
+		fmt.Fprintf(buffer, "size := %d\n", e.size)
+
+		for _, property := range e.properties {
+			property.writeSize(buffer, recieverPrefix)
+		}
+
+		return "size"
+	}
+}
+
+func (e computedExpression) write(buffer *bytes.Buffer, structure *packedStruct, recieverPrefix, reciever, functionName string) {
+
+	path := fieldPath(structure, reciever)
+
+	if e.kind != computedCount && !e.isVariable() {
+
+		switch functionName {
+
+		case "ToBytes":
+			fmt.Fprintf(buffer, "%s = %d\n", reciever, e.size)
+
+		case "FromBytes":
+			fmt.Fprintf(buffer, "if %s != %d {\n", reciever, e.size)
+			fmt.Fprintf(buffer, "return 0, &packed.FieldError{Path: %q, Err: fmt.Errorf(\"%%w: expected %d, got %%d\", packed.ErrComputedMismatch, %s)}\n", path, e.size, reciever)
+			fmt.Fprintf(buffer, "}\n")
+		}
+
+		return
+	}
+
+	fmt.Fprintf(buffer, "{\n")
+
+	value := e.writeValue(buffer, recieverPrefix)
+
+	switch functionName {
+
+	case "ToBytes":
+		if e.maximum != math.MaxUint64 {
+			fmt.Fprintf(buffer, "if uint64(%s) > %d {\n", value, e.maximum)
+			fmt.Fprintf(buffer, "return 0, &packed.FieldError{Path: %q, Err: packed.ErrInvalidLength}\n", path)
+			fmt.Fprintf(buffer, "}\n")
+		}
+
+		fmt.Fprintf(buffer, "%s = %s(%s)\n", reciever, e.typeName, value)
+
+	case "FromBytes":
+		fmt.Fprintf(buffer, "if uint64(%s) != uint64(%s) {\n", value, reciever)
+		fmt.Fprintf(buffer, "return 0, &packed.FieldError{Path: %q, Err: fmt.Errorf(\"%%w: expected %%d, got %%d\", packed.ErrComputedMismatch, %s, %s)}\n", path, value, reciever)
+		fmt.Fprintf(buffer, "}\n")
+	}
+
+	fmt.Fprintf(buffer, "}\n")
+}
+
+func (p *packedProperty) writeComputedChecks(buffer *bytes.Buffer, structure *packedStruct, recieverPrefix string) {
+
+	reciever := recieverPrefix + "." + p.name
+
+	if p.computed.verify {
+		p.computed.write(buffer, structure, recieverPrefix, reciever, "FromBytes")
+	}
+
+	switch p.kind {
+
+	case kindStruct:
+		child := p.packed.(packedStruct)
+
+		if !child.verified {
+			return
+		}
+
+		if p.when != "" {
+			fmt.Fprintf(buffer, "if %s != nil {\n", reciever)
+			reciever = "(*" + reciever + ")"
+		}
+
+		for _, property := range child.properties {
+			property.writeComputedChecks(buffer, structure, reciever)
+		}
+
+		if p.when != "" {
+			fmt.Fprintf(buffer, "}\n")
+		}
+
+	case kindBitFieldGroup:
+		for _, field := range p.packed.(packedBitFieldGroup).fields {
+			if field.packedProperty.computed.verify {
+				field.packedProperty.computed.write(buffer, structure, recieverPrefix, recieverPrefix+"."+field.packedProperty.name, "FromBytes")
+			}
+		}
+	}
+}
@@ -0,0 +1,18 @@
+package packed
+
+import "testing"
+
+func TestComputedSizeOfBitField(t *testing.T) {
+
+	defer func() {
+		if message, _ := recover().(string); message != "computed field Size cannot take the size of bit field Low" {
+			t.Errorf("expected a panic for the size of a bit field, got %q", message)
+		}
+	}()
+
+	Struct("ComputedBitField", false,
+		Field("Low", Bits[uint8](3)),
+		Field("High", Bits[uint8](5)),
+		Field("Size", Uint8, Computed(SizeOf("Low"))),
+	)
+}
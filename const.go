@@ -0,0 +1,175 @@
+package packed
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+type packedConst struct {
+	Element      any
+	ElementKind  kind
+	recieverType reflect.Type
+	value        reflect.Value
+	size         int
+}
+
+func (c packedConst) Size() int { return c.size }
+
+func constRecieverType(element any, elementKind kind) reflect.Type {
+
+	switch elementKind {
+
+	case kindConverter:
+		reciever, _ := implementsConverterInterface(element)
+
+		if overwrite, ok := element.(OverwriteConverterReciverReflectionInterface); ok {
+			reciever = overwrite.OverwriteConverterReciverReflection(reflect.TypeOf(element))
+		}
+
+		return reciever
+
+	case kindConverterCast:
+		return element.(converterCast).target
+
+	case kindType:
+		return reflect.TypeOf(element).Elem()
+
+	case kindArray:
+		array := element.(packedArray)
+		return reflect.ArrayOf(array.Length, constRecieverType(array.Element, array.ElementKind))
+
+	default:
+		panic("constants must be converters, casts, types or arrays of them")
+	}
+}
+
+func Const(converter any, value any) packedConst {
+
+	kind, _, converter := validatePropertyType(converter)
+
+	switch kind {
+	case kindConverter, kindConverterCast, kindType, kindArray:
+	default:
+		panic(fmt.Sprintf("invalid constant type: %T", converter))
+	}
+
+	size := converter.(interface{ Size() int }).Size()
+	reciever := constRecieverType(converter, kind)
+	reflection := reflect.ValueOf(value)
+
+	if !reflection.IsValid() || !reflection.Type().ConvertibleTo(reciever) {
+		panic(fmt.Sprintf("constant value %v is not convertible to %s", value, reciever))
+	}
+
+	return packedConst{
+		Element:      converter,
+		ElementKind:  kind,
+		recieverType: reciever,
+		value:        reflection.Convert(reciever),
+		size:         size,
+	}
+}
+
+func panicOnError(results []reflect.Value) {
+	if len(results) == 1 && !results[0].IsNil() {
+		panic(fmt.Sprintf("invalid constant: %v", results[0].Interface()))
+	}
+}
+
+func encodeConst(element any, elementKind kind, value reflect.Value, littleEndian bool, bytes []byte, index int) {
+
+	methodName := "ToBytesLittleEndian"
+
+	if !littleEndian {
+		methodName = "ToBytesBigEndian"
+	}
+
+	pointer := reflect.New(value.Type())
+	pointer.Elem().Set(value)
+
+	arguments := []reflect.Value{reflect.ValueOf(bytes), reflect.ValueOf(index)}
+
+	switch elementKind {
+
+	case kindConverter:
+		panicOnError(reflect.ValueOf(element).MethodByName(methodName).Call(append([]reflect.Value{pointer}, arguments...)))
+
+	case kindConverterCast:
+		cast := element.(converterCast)
+		reciever := reflect.New(cast.reciever)
+		reciever.Elem().Set(value.Convert(cast.reciever))
+		panicOnError(reflect.ValueOf(cast.converter.instance).MethodByName(methodName).Call(append([]reflect.Value{reciever}, arguments...)))
+
+	case kindType:
+		pointer.MethodByName(methodName).Call(arguments)
+
+	case kindArray:
+		array := element.(packedArray)
+
+		for i := 0; i < array.Length; i++ {
+			encodeConst(array.Element, array.ElementKind, value.Index(i), littleEndian, bytes, index+i*array.ElementSize)
+		}
+
+	default:
+		panic("invalid constant kind")
+	}
+}
+
+func (c packedConst) encode(littleEndian bool) []byte {
+	bytes := make([]byte, c.size)
+	encodeConst(c.Element, c.ElementKind, c.value, littleEndian, bytes, 0)
+	return bytes
+}
+
+func (c packedConst) getterDefinition(structure string, name string) []byte {
+	buffer := &bytes.Buffer{}
+	typeName := c.recieverType.String()
+
+	if cast, ok := c.Element.(converterCast); ok {
+		typeName = cast.targetName()
+	}
+
+	fmt.Fprintf(buffer, "func (reciever *%s) %s() %s {\n", structure, name, typeName)
+	fmt.Fprintf(buffer, "return %#v\n", c.value.Interface())
+	fmt.Fprintf(buffer, "}\n")
+	return buffer.Bytes()
+}
+
+func byteStringLiteral(value []byte) string {
+
+	literal := &strings.Builder{}
+	literal.WriteByte('"')
+
+	for _, character := range value {
+		if character >= 0x20 && character < 0x7F && character != '"' && character != '\\' {
+			literal.WriteByte(character)
+		} else {
+			fmt.Fprintf(literal, "\\x%02x", character)
+		}
+	}
+
+	literal.WriteByte('"')
+
+	return literal.String()
+}
+
+func (c packedConst) write(buffer *bytes.Buffer, structure *packedStruct, reciever string, functionName string, littleEndian bool, offset string) {
+
+	encoded := byteStringLiteral(c.encode(littleEndian))
+
+	switch functionName {
+
+	case "ToBytes":
+		fmt.Fprintf(buffer, "copy(bytes[%s:], %s)\n", offset, encoded)
+
+	case "FromBytes":
+		fmt.Fprintf(buffer, "if string(bytes[%s:%s+%d]) != %s {\n", offset, offset, c.size, encoded)
+		fmt.Fprintf(buffer, "return 0, &packed.FieldError{Path: %q, Err: fmt.Errorf(\"%%w: expected %% x, got %% x\", packed.ErrConstMismatch, %s, bytes[%s:%s+%d])}\n", fieldPath(structure, reciever), encoded, offset, offset, c.size)
+		fmt.Fprintf(buffer, "}\n")
+
+	default:
+		panic("invalid function name")
+	}
+}
@@ -0,0 +1,459 @@
+package packed
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+)
+
+type constraintKind int
+
+const (
+	constraintRange constraintKind = iota
+	constraintOneOf
+	constraintNotZero
+	constraintValidEnum
+)
+
+type fieldConstraint struct {
+	kind   constraintKind
+	values []string
+	zero   string
+}
+
+func constraintType(definition packedProperty) reflect.Type {
+
+	var reflection reflect.Type
+
+	switch definition.kind {
+
+	case kindArray:
+		array := definition.packed.(packedArray)
+
+		for array.ElementKind == kindArray {
+			array = array.Element.(packedArray)
+		}
+
+		if array.ElementKind == kindStruct {
+			panic(fmt.Sprintf("constraints cannot be set for arrays of structs in %s", definition.name))
+		}
+
+		reflection = constRecieverType(definition.packed, definition.kind)
+
+	case kindConverter, kindConverterCast, kindType:
+		reflection = constRecieverType(definition.packed, definition.kind)
+
+	case kindVariable:
+		return definition.recieverType
+
+	case kindSlice:
+		slice := definition.packed.(packedSlice)
+
+		switch slice.sliceKind {
+		case sliceKindString:
+			return reflect.TypeOf("")
+		case sliceKindBytes:
+			return reflect.TypeOf(byte(0))
+		}
+
+		reflection = constRecieverType(slice.Element, slice.ElementKind)
+
+	case kindBitField:
+		field := definition.packed.(packedBitField)
+
+		switch field.bitFieldKind {
+		case bitFieldKindBitsType, bitFieldKindBitsConverter:
+			return field.bitsTargetReflection.Elem()
+		default:
+			return field.reflection
+		}
+
+	default:
+		panic(fmt.Sprintf("constraints cannot be set for %s", definition.name))
+	}
+
+	for reflection.Kind() == reflect.Array {
+		reflection = reflection.Elem()
+	}
+
+	return reflection
+}
+
+func constraintFits(value reflect.Value, reflection reflect.Type) bool {
+
+	switch reflection.Kind() {
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+
+		if value.CanFloat() {
+			float := value.Float()
+
+			if float != math.Trunc(float) || float < math.MinInt64 || float >= math.MaxUint64 {
+				return false
+			}
+
+			if float < 0 {
+				value = reflect.ValueOf(int64(float))
+			} else {
+				value = reflect.ValueOf(uint64(float))
+			}
+		}
+
+		if !value.CanInt() && !value.CanUint() {
+			return true
+		}
+
+		return fitsInteger(value, reflection, integerMaximum(reflection, 0))
+
+	case reflect.Float32, reflect.Float64:
+		return !reflect.Zero(reflection).OverflowFloat(value.Convert(reflect.TypeFor[float64]()).Float())
+	}
+
+	return true
+}
+
+func constraintValues(definition packedProperty, values []any) []string {
+
+	reflection := constraintType(definition)
+	literals := []string{}
+
+	for _, value := range values {
+
+		if value == nil || !reflect.TypeOf(value).ConvertibleTo(reflection) {
+			panic(fmt.Sprintf("constraint value %v for %s is not convertible to %s", value, definition.name, reflection))
+		}
+
+		if !constraintFits(reflect.ValueOf(value), reflection) {
+			panic(fmt.Sprintf("constraint value %v for %s does not fit in %s", value, definition.name, reflection))
+		}
+
+		literals = append(literals, fmt.Sprintf("%#v", value))
+	}
+
+	return literals
+}
+
+func Range(minimum, maximum any) fieldOption {
+	return func(definition *packedProperty) {
+		definition.constraints = append(definition.constraints, fieldConstraint{
+			kind:   constraintRange,
+			values: constraintValues(*definition, []any{minimum, maximum}),
+		})
+	}
+}
+
+func OneOf(values ...any) fieldOption {
+	return func(definition *packedProperty) {
+
+		if len(values) == 0 {
+			panic("one of constraints need at least one value")
+		}
+
+		definition.constraints = append(definition.constraints, fieldConstraint{
+			kind:   constraintOneOf,
+			values: constraintValues(*definition, values),
+		})
+	}
+}
+
+func NotZero() fieldOption {
+	return func(definition *packedProperty) {
+		definition.constraints = append(definition.constraints, fieldConstraint{
+			kind: constraintNotZero,
+			zero: fmt.Sprintf("%#v", reflect.Zero(constraintType(*definition)).Interface()),
+		})
+	}
+}
+
+func enumProperty(packed any, kind kind) bool {
+
+	switch kind {
+
+	case kindConverterCast:
+		return packed.(converterCast).enum != nil
+
+	case kindBitField:
+		return packed.(packedBitField).enum != nil
+
+	case kindArray:
+		array := packed.(packedArray)
+		return enumProperty(array.Element, array.ElementKind)
+
+	case kindSlice:
+		slice := packed.(packedSlice)
+		return slice.sliceKind == sliceKindElements && enumProperty(slice.Element, slice.ElementKind)
+	}
+
+	return false
+}
+
+func ValidEnum() fieldOption {
+	return func(definition *packedProperty) {
+
+		if !enumProperty(definition.packed, definition.kind) {
+
+			reflection := constraintType(*definition)
+			method, ok := reflect.PointerTo(reflection).MethodByName("IsValid")
+
+			if !ok || method.Type.NumIn() != 1 || method.Type.NumOut() != 1 || method.Type.Out(0).Kind() != reflect.Bool {
+				panic(fmt.Sprintf("%s does not have an IsValid() bool method", reflection))
+			}
+		}
+
+		definition.constraints = append(definition.constraints, fieldConstraint{kind: constraintValidEnum})
+	}
+}
+
+func fieldPathExpression(structure *packedStruct, reciever string) string {
+
+	indexes := []string{}
+
+	reciever = fieldPathIndex.ReplaceAllStringFunc(fieldPathReplacer.Replace(reciever), func(index string) string {
+		indexes = append(indexes, strings.Trim(index, "[]"))
+		return "[%d]"
+	})
+
+	path := structure.name + strings.TrimSuffix(strings.TrimPrefix(reciever, "reciever"), ".")
+
+	if len(indexes) == 0 {
+		return fmt.Sprintf("%q", path)
+	}
+
+	return fmt.Sprintf("fmt.Sprintf(%q, %s)", path, strings.Join(indexes, ", "))
+}
+
+func (c fieldConstraint) write(buffer *bytes.Buffer, reciever string, path string) {
+
+	var message string
+
+	switch c.kind {
+
+	case constraintRange:
+		fmt.Fprintf(buffer, "if %s < %s || %s > %s {\n", reciever, c.values[0], reciever, c.values[1])
+		message = fmt.Sprintf("fmt.Errorf(\"%%w: %%v is not between %%v and %%v\", packed.ErrInvalidValue, %s, %s, %s)", reciever, c.values[0], c.values[1])
+
+	case constraintOneOf:
+		fmt.Fprintf(buffer, "switch %s {\n", reciever)
+		fmt.Fprintf(buffer, "case %s:\n", strings.Join(c.values, ", "))
+		fmt.Fprintf(buffer, "default:\n")
+		message = fmt.Sprintf("fmt.Errorf(\"%%w: %%v is not one of %s\", packed.ErrInvalidValue, %s)", strings.ReplaceAll(strings.Join(c.values, ", "), `"`, `\"`), reciever)
+
+	case constraintNotZero:
+		fmt.Fprintf(buffer, "if %s == %s {\n", reciever, c.zero)
+		message = "fmt.Errorf(\"%w: value is zero\", packed.ErrInvalidValue)"
+
+	case constraintValidEnum:
+		fmt.Fprintf(buffer, "if !%s.IsValid() {\n", reciever)
+		message = fmt.Sprintf("fmt.Errorf(\"%%w: %%v is not a valid value\", packed.ErrInvalidValue, %s)", reciever)
+	}
+
+	fmt.Fprintf(buffer, "return &packed.FieldError{Path: %s, Err: %s}\n", path, message)
+	fmt.Fprintf(buffer, "}\n")
+}
+
+func (p *packedStruct) validated() bool {
+
+	for _, property := range p.properties {
+		if property.validated() {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (u packedUnion) validated() bool {
+
+	for _, variant := range u.variants {
+		if variant.structure.validated() {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (p *packedStruct) validatedVariants(variants map[string]bool) {
+
+	for _, property := range p.properties {
+
+		switch property.kind {
+
+		case kindStruct:
+			child := property.packed.(packedStruct)
+			child.validatedVariants(variants)
+
+		case kindUnion:
+			union := property.packed.(packedUnion)
+
+			if !union.validated() {
+				continue
+			}
+
+			for _, variant := range union.variants {
+				variants[variant.structure.name] = true
+			}
+		}
+	}
+}
+
+func elementValidated(element any, elementKind kind) bool {
+
+	switch elementKind {
+
+	case kindStruct:
+		structure := element.(packedStruct)
+		return structure.validated()
+
+	case kindArray:
+		array := element.(packedArray)
+		return elementValidated(array.Element, array.ElementKind)
+	}
+
+	return false
+}
+
+func (p *packedProperty) validated() bool {
+
+	if len(p.constraints) > 0 {
+		return true
+	}
+
+	switch p.kind {
+
+	case kindStruct:
+		structure := p.packed.(packedStruct)
+		return structure.validated()
+
+	case kindUnion:
+		return p.packed.(packedUnion).validated()
+
+	case kindBitFieldGroup:
+		for _, field := range p.packed.(packedBitFieldGroup).fields {
+			if len(field.packedProperty.constraints) > 0 {
+				return true
+			}
+		}
+
+	case kindArray:
+		array := p.packed.(packedArray)
+		return elementValidated(array.Element, array.ElementKind)
+
+	case kindSlice:
+		slice := p.packed.(packedSlice)
+		return slice.sliceKind == sliceKindElements && elementValidated(slice.Element, slice.ElementKind)
+	}
+
+	return false
+}
+
+func writeConstraints(buffer *bytes.Buffer, structure *packedStruct, constraints []fieldConstraint, reciever string) {
+	for _, constraint := range constraints {
+		constraint.write(buffer, reciever, fieldPathExpression(structure, reciever))
+	}
+}
+
+func writeElementValidation(buffer *bytes.Buffer, structure *packedStruct, element any, elementKind kind, constraints []fieldConstraint, reciever string, depth int) {
+
+	indexVariable := fmt.Sprintf("i%d", depth)
+
+	fmt.Fprintf(buffer, "for %s := range %s {\n", indexVariable, reciever)
+
+	reciever = fmt.Sprintf("%s[%s]", reciever, indexVariable)
+
+	switch elementKind {
+
+	case kindStruct:
+		for _, property := range element.(packedStruct).properties {
+			property.writeValidate(buffer, structure, reciever, depth+1)
+		}
+
+	case kindArray:
+		array := element.(packedArray)
+		writeElementValidation(buffer, structure, array.Element, array.ElementKind, constraints, reciever, depth+1)
+
+	default:
+		writeConstraints(buffer, structure, constraints, reciever)
+	}
+
+	fmt.Fprintf(buffer, "}\n")
+}
+
+func (p *packedProperty) writeValidate(buffer *bytes.Buffer, structure *packedStruct, recieverPrefix string, depth int) {
+
+	if !p.validated() {
+		return
+	}
+
+	reciever := recieverPrefix + "." + p.name
+
+	if p.when != "" {
+		fmt.Fprintf(buffer, "if %s != nil {\n", reciever)
+		defer fmt.Fprintf(buffer, "}\n")
+
+		reciever = "(*" + reciever + ")"
+	}
+
+	switch p.kind {
+
+	case kindStruct:
+		for _, child := range p.packed.(packedStruct).properties {
+			child.writeValidate(buffer, structure, reciever, depth)
+		}
+
+	case kindBitFieldGroup:
+		for _, field := range p.packed.(packedBitFieldGroup).fields {
+			writeConstraints(buffer, structure, field.packedProperty.constraints, recieverPrefix+"."+field.packedProperty.name)
+		}
+
+	case kindUnion:
+		fmt.Fprintf(buffer, "if %s != nil {\n", reciever)
+		fmt.Fprintf(buffer, "if err := %s.Validate(); err != nil {\n", reciever)
+		fmt.Fprintf(buffer, "var fieldError *packed.FieldError\n")
+		fmt.Fprintf(buffer, "if !errors.As(err, &fieldError) {\n")
+		fmt.Fprintf(buffer, "return &packed.FieldError{Path: %s, Err: err}\n", fieldPathExpression(structure, reciever))
+		fmt.Fprintf(buffer, "}\n")
+		fmt.Fprintf(buffer, "if _, path, found := strings.Cut(fieldError.Path, \".\"); found {\n")
+		fmt.Fprintf(buffer, "return &packed.FieldError{Path: %s + \".\" + path, Err: fieldError.Err}\n", fieldPathExpression(structure, reciever))
+		fmt.Fprintf(buffer, "}\n")
+		fmt.Fprintf(buffer, "return &packed.FieldError{Path: %s, Err: fieldError.Err}\n", fieldPathExpression(structure, reciever))
+		fmt.Fprintf(buffer, "}\n")
+		fmt.Fprintf(buffer, "}\n")
+
+	case kindArray:
+		array := p.packed.(packedArray)
+		writeElementValidation(buffer, structure, array.Element, array.ElementKind, p.constraints, reciever, depth)
+
+	case kindSlice:
+		slice := p.packed.(packedSlice)
+
+		if slice.sliceKind == sliceKindString {
+			writeConstraints(buffer, structure, p.constraints, reciever)
+			return
+		}
+
+		writeElementValidation(buffer, structure, slice.Element, slice.ElementKind, p.constraints, reciever, depth)
+
+	default:
+		writeConstraints(buffer, structure, p.constraints, reciever)
+	}
+}
+
+func (p *packedStruct) validateDefinition() []byte {
+
+	buffer := &bytes.Buffer{}
+
+	fmt.Fprintf(buffer, "func (reciever *%s) Validate() error {\n", p.name)
+
+	for _, property := range p.properties {
+		property.writeValidate(buffer, p, "reciever", 0)
+	}
+
+	fmt.Fprintf(buffer, "return nil\n")
+	fmt.Fprintf(buffer, "}\n")
+
+	return buffer.Bytes()
+}
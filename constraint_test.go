@@ -0,0 +1,33 @@
+package packed
+
+import "testing"
+
+func TestConstraintValuesFit(t *testing.T) {
+
+	tests := map[string]func(){
+		"negative unsigned":  func() { Struct("RangeNegative", false, Field("A", Uint8, Range(-1, 10))) },
+		"one of byte":        func() { Struct("OneOfByte", false, Field("A", Uint8, OneOf(1, 300))) },
+		"signed maximum":     func() { Struct("RangeSigned", false, Field("A", Int8, Range(-128, 128))) },
+		"fraction integer":   func() { Struct("RangeFraction", false, Field("A", Uint16, Range(0.5, 10))) },
+		"float32 overflow":   func() { Struct("RangeFloat", false, Field("A", Float32, Range(0, 1e39))) },
+		"array element size": func() { Struct("RangeArray", false, Field("A", Array(2, Int16), Range(0, 40000))) },
+	}
+
+	for name, test := range tests {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s: expected a panic", name)
+				}
+			}()
+			test()
+		}()
+	}
+
+	Struct("RangeFits", false,
+		Field("A", Uint8, Range(0, 255)),
+		Field("B", Int8, OneOf(-128, 127)),
+		Field("C", Uint16, Range(1.0, 10.0)),
+		Field("D", Float32, Range(-1.5, 1e38)),
+	)
+}
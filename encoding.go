@@ -0,0 +1,340 @@
+package packed
+
+import (
+	"fmt"
+	"math"
+)
+
+func lowBits(bits int) uint64 {
+	if bits >= 64 {
+		return math.MaxUint64
+	}
+	return uint64(1)<<bits - 1
+}
+
+func putInteger(integer uint64, size int, bytes []byte, index int, littleEndian bool) {
+	for i := 0; i < size; i++ {
+		if littleEndian {
+			bytes[index+i] = byte(integer >> (8 * i))
+		} else {
+			bytes[index+size-1-i] = byte(integer >> (8 * i))
+		}
+	}
+}
+
+func getInteger(size int, bytes []byte, index int, littleEndian bool) uint64 {
+	var integer uint64
+	for i := 0; i < size; i++ {
+		if littleEndian {
+			integer |= uint64(bytes[index+i]) << (8 * i)
+		} else {
+			integer |= uint64(bytes[index+size-1-i]) << (8 * i)
+		}
+	}
+	return integer
+}
+
+func validateEncodingBits(name string, bits, minimum int) {
+	if bits < minimum || bits > 64 {
+		panic(fmt.Sprintf("%s bits must be between %d and 64, got %d", name, minimum, bits))
+	}
+}
+
+func BCD(digits int) BCDConverter {
+
+	if digits < 1 || digits > 16 {
+		panic(fmt.Sprintf("bcd digits must be between 1 and 16, got %d", digits))
+	}
+
+	return BCDConverter{Digits: digits}
+}
+
+type BCDConverter struct {
+	Digits       int  `packed_hash_field:"digits"`
+	StrictDecode bool `packed_hash_field:"strict"`
+}
+
+func (c BCDConverter) Strict() BCDConverter {
+	c.StrictDecode = true
+	return c
+}
+
+func (c *BCDConverter) InitializeConverterFields() map[string]string {
+	return map[string]string{
+		"Digits":       fmt.Sprintf("%d", c.Digits),
+		"StrictDecode": fmt.Sprintf("%v", c.StrictDecode),
+	}
+}
+
+func (c *BCDConverter) Size() int { return (c.Digits + 1) / 2 }
+
+func (c *BCDConverter) bitWidth() int { return c.Digits * 4 }
+
+func (c *BCDConverter) Integer(value *uint64) (uint64, error) {
+
+	if *value > uint64(math.Pow10(c.Digits))-1 {
+		return 0, fmt.Errorf("%w: %d does not fit in %d bcd digits", ErrOverflow, *value, c.Digits)
+	}
+
+	var integer uint64
+
+	for i, v := 0, *value; v > 0; i, v = i+1, v/10 {
+		integer |= (v % 10) << (4 * i)
+	}
+
+	return integer, nil
+}
+
+func (c *BCDConverter) Set(reciever *uint64, integer uint64) error {
+
+	if c.StrictDecode && integer>>(4*c.Digits) != 0 {
+		return fmt.Errorf("%w: bcd value %#x has more than %d digits", ErrInvalidValue, integer, c.Digits)
+	}
+
+	var value uint64
+
+	for i := c.Digits - 1; i >= 0; i-- {
+		digit := integer >> (4 * i) & 0xF
+
+		if c.StrictDecode && digit > 9 {
+			return fmt.Errorf("%w: invalid bcd digit %X", ErrInvalidValue, digit)
+		}
+
+		value = value*10 + digit
+	}
+
+	*reciever = value
+	return nil
+}
+
+func (c *BCDConverter) ToBytesLittleEndian(value *uint64, bytes []byte, index int) error {
+	integer, err := c.Integer(value)
+	if err != nil {
+		return err
+	}
+	putInteger(integer, c.Size(), bytes, index, true)
+	return nil
+}
+
+func (c *BCDConverter) FromBytesLittleEndian(reciever *uint64, bytes []byte, index int) error {
+	return c.Set(reciever, getInteger(c.Size(), bytes, index, true))
+}
+
+func (c *BCDConverter) ToBytesBigEndian(value *uint64, bytes []byte, index int) error {
+	integer, err := c.Integer(value)
+	if err != nil {
+		return err
+	}
+	putInteger(integer, c.Size(), bytes, index, false)
+	return nil
+}
+
+func (c *BCDConverter) FromBytesBigEndian(reciever *uint64, bytes []byte, index int) error {
+	return c.Set(reciever, getInteger(c.Size(), bytes, index, false))
+}
+
+func SignMagnitude(bits int) SignMagnitudeConverter {
+	validateEncodingBits("sign magnitude", bits, 2)
+	return SignMagnitudeConverter{Bits: bits}
+}
+
+type SignMagnitudeConverter struct {
+	Bits int `packed_hash_field:"bits"`
+}
+
+func (c *SignMagnitudeConverter) InitializeConverterFields() map[string]string {
+	return map[string]string{"Bits": fmt.Sprintf("%d", c.Bits)}
+}
+
+func (c *SignMagnitudeConverter) Size() int { return (c.Bits + 7) / 8 }
+
+func (c *SignMagnitudeConverter) bitWidth() int { return c.Bits }
+
+func (c *SignMagnitudeConverter) Integer(value *int64) (uint64, error) {
+
+	magnitude, sign := uint64(*value), uint64(0)
+
+	if *value < 0 {
+		magnitude, sign = -magnitude, 1
+	}
+
+	if magnitude > lowBits(c.Bits-1) {
+		return 0, fmt.Errorf("%w: %d does not fit in %d bit sign magnitude", ErrOverflow, *value, c.Bits)
+	}
+
+	return sign<<(c.Bits-1) | magnitude, nil
+}
+
+func (c *SignMagnitudeConverter) Set(reciever *int64, integer uint64) {
+
+	magnitude := int64(integer & lowBits(c.Bits-1))
+
+	if integer>>(c.Bits-1)&1 == 1 {
+		magnitude = -magnitude
+	}
+
+	*reciever = magnitude
+}
+
+func (c *SignMagnitudeConverter) ToBytesLittleEndian(value *int64, bytes []byte, index int) error {
+	integer, err := c.Integer(value)
+	if err != nil {
+		return err
+	}
+	putInteger(integer, c.Size(), bytes, index, true)
+	return nil
+}
+
+func (c *SignMagnitudeConverter) FromBytesLittleEndian(reciever *int64, bytes []byte, index int) {
+	c.Set(reciever, getInteger(c.Size(), bytes, index, true))
+}
+
+func (c *SignMagnitudeConverter) ToBytesBigEndian(value *int64, bytes []byte, index int) error {
+	integer, err := c.Integer(value)
+	if err != nil {
+		return err
+	}
+	putInteger(integer, c.Size(), bytes, index, false)
+	return nil
+}
+
+func (c *SignMagnitudeConverter) FromBytesBigEndian(reciever *int64, bytes []byte, index int) {
+	c.Set(reciever, getInteger(c.Size(), bytes, index, false))
+}
+
+func OnesComplement(bits int) OnesComplementConverter {
+	validateEncodingBits("ones complement", bits, 2)
+	return OnesComplementConverter{Bits: bits}
+}
+
+type OnesComplementConverter struct {
+	Bits int `packed_hash_field:"bits"`
+}
+
+func (c *OnesComplementConverter) InitializeConverterFields() map[string]string {
+	return map[string]string{"Bits": fmt.Sprintf("%d", c.Bits)}
+}
+
+func (c *OnesComplementConverter) Size() int { return (c.Bits + 7) / 8 }
+
+func (c *OnesComplementConverter) bitWidth() int { return c.Bits }
+
+func (c *OnesComplementConverter) Integer(value *int64) (uint64, error) {
+
+	magnitude := uint64(*value)
+
+	if *value < 0 {
+		magnitude = -magnitude
+	}
+
+	if magnitude > lowBits(c.Bits-1) {
+		return 0, fmt.Errorf("%w: %d does not fit in %d bit ones complement", ErrOverflow, *value, c.Bits)
+	}
+
+	if *value < 0 {
+		return ^magnitude & lowBits(c.Bits), nil
+	}
+
+	return magnitude, nil
+}
+
+func (c *OnesComplementConverter) Set(reciever *int64, integer uint64) {
+
+	integer &= lowBits(c.Bits)
+
+	if integer>>(c.Bits-1) == 1 {
+		*reciever = -int64(^integer & lowBits(c.Bits))
+		return
+	}
+
+	*reciever = int64(integer)
+}
+
+func (c *OnesComplementConverter) ToBytesLittleEndian(value *int64, bytes []byte, index int) error {
+	integer, err := c.Integer(value)
+	if err != nil {
+		return err
+	}
+	putInteger(integer, c.Size(), bytes, index, true)
+	return nil
+}
+
+func (c *OnesComplementConverter) FromBytesLittleEndian(reciever *int64, bytes []byte, index int) {
+	c.Set(reciever, getInteger(c.Size(), bytes, index, true))
+}
+
+func (c *OnesComplementConverter) ToBytesBigEndian(value *int64, bytes []byte, index int) error {
+	integer, err := c.Integer(value)
+	if err != nil {
+		return err
+	}
+	putInteger(integer, c.Size(), bytes, index, false)
+	return nil
+}
+
+func (c *OnesComplementConverter) FromBytesBigEndian(reciever *int64, bytes []byte, index int) {
+	c.Set(reciever, getInteger(c.Size(), bytes, index, false))
+}
+
+func Gray(bits int) GrayConverter {
+	validateEncodingBits("gray code", bits, 1)
+	return GrayConverter{Bits: bits}
+}
+
+type GrayConverter struct {
+	Bits int `packed_hash_field:"bits"`
+}
+
+func (c *GrayConverter) InitializeConverterFields() map[string]string {
+	return map[string]string{"Bits": fmt.Sprintf("%d", c.Bits)}
+}
+
+func (c *GrayConverter) Size() int { return (c.Bits + 7) / 8 }
+
+func (c *GrayConverter) bitWidth() int { return c.Bits }
+
+func (c *GrayConverter) Integer(value *uint64) (uint64, error) {
+
+	if *value > lowBits(c.Bits) {
+		return 0, fmt.Errorf("%w: %d does not fit in %d bit gray code", ErrOverflow, *value, c.Bits)
+	}
+
+	return *value ^ *value>>1, nil
+}
+
+func (c *GrayConverter) Set(reciever *uint64, integer uint64) {
+
+	integer &= lowBits(c.Bits)
+
+	for shift := 1; shift < c.Bits; shift <<= 1 {
+		integer ^= integer >> shift
+	}
+
+	*reciever = integer
+}
+
+func (c *GrayConverter) ToBytesLittleEndian(value *uint64, bytes []byte, index int) error {
+	integer, err := c.Integer(value)
+	if err != nil {
+		return err
+	}
+	putInteger(integer, c.Size(), bytes, index, true)
+	return nil
+}
+
+func (c *GrayConverter) FromBytesLittleEndian(reciever *uint64, bytes []byte, index int) {
+	c.Set(reciever, getInteger(c.Size(), bytes, index, true))
+}
+
+func (c *GrayConverter) ToBytesBigEndian(value *uint64, bytes []byte, index int) error {
+	integer, err := c.Integer(value)
+	if err != nil {
+		return err
+	}
+	putInteger(integer, c.Size(), bytes, index, false)
+	return nil
+}
+
+func (c *GrayConverter) FromBytesBigEndian(reciever *uint64, bytes []byte, index int) {
+	c.Set(reciever, getInteger(c.Size(), bytes, index, false))
+}
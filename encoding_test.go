@@ -0,0 +1,216 @@
+package packed
+
+import (
+	"errors"
+	"math"
+	"math/bits"
+	"slices"
+	"testing"
+)
+
+func TestBCDConverter(t *testing.T) {
+	converter := BCD(4)
+
+	value := uint64(1234)
+	bytes := make([]byte, converter.Size())
+
+	if err := converter.ToBytesBigEndian(&value, bytes, 0); err != nil || !slices.Equal(bytes, []byte{0x12, 0x34}) {
+		t.Errorf("BCD ToBytesBigEndian %d: got %x, %v", value, bytes, err)
+	}
+
+	var result uint64
+	if err := converter.FromBytesBigEndian(&result, bytes, 0); err != nil || result != value {
+		t.Errorf("BCD FromBytesBigEndian %x: expected %d, got %d, %v", bytes, value, result, err)
+	}
+
+	if err := converter.ToBytesLittleEndian(&value, bytes, 0); err != nil || !slices.Equal(bytes, []byte{0x34, 0x12}) {
+		t.Errorf("BCD ToBytesLittleEndian %d: got %x, %v", value, bytes, err)
+	}
+
+	if err := converter.FromBytesLittleEndian(&result, bytes, 0); err != nil || result != value {
+		t.Errorf("BCD FromBytesLittleEndian %x: expected %d, got %d, %v", bytes, value, result, err)
+	}
+
+	for _, value := range []uint64{0, 9, 10, 9999} {
+		if err := converter.ToBytesBigEndian(&value, bytes, 0); err != nil {
+			t.Errorf("BCD %d: unexpected error %v", value, err)
+		}
+		if err := converter.FromBytesBigEndian(&result, bytes, 0); err != nil || result != value {
+			t.Errorf("BCD %d: got %d, %v", value, result, err)
+		}
+	}
+
+	value = 10000
+	if err := converter.ToBytesBigEndian(&value, bytes, 0); !errors.Is(err, ErrOverflow) {
+		t.Errorf("BCD %d: expected overflow, got %v", value, err)
+	}
+
+	if err := converter.FromBytesBigEndian(&result, []byte{0x1A, 0x00}, 0); err != nil || result != 2000 {
+		t.Errorf("BCD lenient FromBytesBigEndian 1a00: expected 2000, got %d, %v", result, err)
+	}
+
+	strict := BCD(3).Strict()
+
+	if strict.Size() != 2 {
+		t.Errorf("BCD(3) Size: expected 2, got %d", strict.Size())
+	}
+
+	if err := strict.FromBytesBigEndian(&result, []byte{0x01, 0xA0}, 0); !errors.Is(err, ErrInvalidValue) {
+		t.Errorf("BCD strict 01a0: expected invalid value, got %v", err)
+	}
+
+	if err := strict.FromBytesBigEndian(&result, []byte{0x12, 0x34}, 0); !errors.Is(err, ErrInvalidValue) {
+		t.Errorf("BCD strict 1234 in 3 digits: expected invalid value, got %v", err)
+	}
+
+	if err := strict.FromBytesBigEndian(&result, []byte{0x09, 0x87}, 0); err != nil || result != 987 {
+		t.Errorf("BCD strict 0987: expected 987, got %d, %v", result, err)
+	}
+}
+
+func TestSignMagnitudeConverter(t *testing.T) {
+	converter := SignMagnitude(8)
+
+	values := map[int64]byte{0: 0x00, 5: 0x05, -5: 0x85, 127: 0x7F, -127: 0xFF}
+
+	for original, expected := range values {
+		bytes := make([]byte, 1)
+
+		if err := converter.ToBytesBigEndian(&original, bytes, 0); err != nil || bytes[0] != expected {
+			t.Errorf("SignMagnitude %d: expected %#02x, got %#02x, %v", original, expected, bytes[0], err)
+		}
+
+		var result int64
+		converter.FromBytesLittleEndian(&result, bytes, 0)
+		if result != original {
+			t.Errorf("SignMagnitude %#02x: expected %d, got %d", expected, original, result)
+		}
+	}
+
+	var result int64
+	converter.FromBytesBigEndian(&result, []byte{0x80}, 0)
+	if result != 0 {
+		t.Errorf("SignMagnitude negative zero: got %d", result)
+	}
+
+	for _, overflow := range []int64{128, -128} {
+		if err := converter.ToBytesBigEndian(&overflow, make([]byte, 1), 0); !errors.Is(err, ErrOverflow) {
+			t.Errorf("SignMagnitude %d: expected overflow, got %v", overflow, err)
+		}
+	}
+
+	wide := SignMagnitude(64)
+	minimum := int64(math.MinInt64)
+	if err := wide.ToBytesBigEndian(&minimum, make([]byte, 8), 0); !errors.Is(err, ErrOverflow) {
+		t.Errorf("SignMagnitude(64) %d: expected overflow, got %v", minimum, err)
+	}
+
+	value := int64(-math.MaxInt64)
+	bytes := make([]byte, 8)
+	if err := wide.ToBytesLittleEndian(&value, bytes, 0); err != nil || !slices.Equal(bytes, slices.Repeat([]byte{0xFF}, 8)) {
+		t.Errorf("SignMagnitude(64) %d: got %x, %v", value, bytes, err)
+	}
+}
+
+func TestOnesComplementConverter(t *testing.T) {
+	converter := OnesComplement(16)
+
+	values := map[int64][]byte{0: {0x00, 0x00}, 5: {0x00, 0x05}, -5: {0xFF, 0xFA}, 32767: {0x7F, 0xFF}, -32767: {0x80, 0x00}}
+
+	for original, expected := range values {
+		bytes := make([]byte, 2)
+
+		if err := converter.ToBytesBigEndian(&original, bytes, 0); err != nil || !slices.Equal(bytes, expected) {
+			t.Errorf("OnesComplement %d: expected %x, got %x, %v", original, expected, bytes, err)
+		}
+
+		var result int64
+		converter.FromBytesBigEndian(&result, bytes, 0)
+		if result != original {
+			t.Errorf("OnesComplement %x: expected %d, got %d", expected, original, result)
+		}
+	}
+
+	var result int64
+	converter.FromBytesLittleEndian(&result, []byte{0xFF, 0xFF}, 0)
+	if result != 0 {
+		t.Errorf("OnesComplement negative zero: got %d", result)
+	}
+
+	for _, overflow := range []int64{32768, -32768} {
+		if err := converter.ToBytesBigEndian(&overflow, make([]byte, 2), 0); !errors.Is(err, ErrOverflow) {
+			t.Errorf("OnesComplement %d: expected overflow, got %v", overflow, err)
+		}
+	}
+}
+
+func TestGrayConverter(t *testing.T) {
+	converter := Gray(10)
+
+	var previous uint64
+
+	for value := uint64(0); value < 1024; value++ {
+		gray, err := converter.Integer(&value)
+
+		if err != nil {
+			t.Fatalf("Gray %d: unexpected error %v", value, err)
+		}
+
+		if value > 0 && bits.OnesCount64(gray^previous) != 1 {
+			t.Errorf("Gray %d: %#x and %#x differ in more than one bit", value, previous, gray)
+		}
+
+		var result uint64
+		converter.Set(&result, gray)
+		if result != value {
+			t.Errorf("Gray %#x: expected %d, got %d", gray, value, result)
+		}
+
+		previous = gray
+	}
+
+	value := uint64(1024)
+	if err := converter.ToBytesBigEndian(&value, make([]byte, 2), 0); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Gray %d: expected overflow, got %v", value, err)
+	}
+
+	wide := Gray(64)
+	bytes := make([]byte, 8)
+
+	for _, value := range []uint64{0, 1, math.MaxUint64, 0x0123456789ABCDEF} {
+		if err := wide.ToBytesLittleEndian(&value, bytes, 0); err != nil {
+			t.Errorf("Gray(64) %d: unexpected error %v", value, err)
+		}
+
+		var result uint64
+		wide.FromBytesLittleEndian(&result, bytes, 0)
+		if result != value {
+			t.Errorf("Gray(64) %d: got %d", value, result)
+		}
+	}
+}
+
+func TestEncodingBitFieldWidths(t *testing.T) {
+
+	tests := map[string]func(){
+		"bcd":             func() { Bits[uint64](7, BCD(2)) },
+		"sign magnitude":  func() { Bits[uint64](4, SignMagnitude(5)) },
+		"ones complement": func() { Bits[uint64](4, OnesComplement(8)) },
+		"gray":            func() { Bits[uint64](4, Gray(12)) },
+	}
+
+	for name, test := range tests {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s: expected a panic", name)
+				}
+			}()
+			test()
+		}()
+	}
+
+	Bits[uint64](8, BCD(2))
+	Bits[uint64](5, SignMagnitude(5))
+	Bits[uint64](12, Gray(12))
+}
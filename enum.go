@@ -0,0 +1,196 @@
+package packed
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+type enumValue struct {
+	name  string
+	value any
+}
+
+type packedEnum struct {
+	name       string
+	underlying reflect.Type
+	values     []enumValue
+	literals   []string
+}
+
+func EnumValue(name string, value any) enumValue {
+	return enumValue{name: name, value: value}
+}
+
+func Enum(name string, converter any, values ...enumValue) converterCast {
+
+	if _, ok := enums[name]; ok {
+		panic(fmt.Sprintf("enum %s already exists", name))
+	}
+
+	if _, ok := flagSets[name]; ok {
+		panic(fmt.Sprintf("enum %s conflicts with flags of the same name", name))
+	}
+
+	if _, ok := fixedStrings[name]; ok {
+		panic(fmt.Sprintf("enum %s conflicts with a fixed string of the same name", name))
+	}
+
+	if len(values) == 0 {
+		panic(fmt.Sprintf("enum %s must have at least one value", name))
+	}
+
+	reciever, ok := implementsConverterInterface(toPointer(converter))
+
+	if !ok {
+		panic("not a valid converter")
+	}
+
+	if integerMaximum(reciever, 0) == 0 {
+		panic(fmt.Sprintf("enum %s must be backed by an integer converter", name))
+	}
+
+	enum := &packedEnum{name: name, underlying: reciever}
+	names := map[string]bool{}
+	literals := map[string]bool{}
+
+	for _, value := range values {
+
+		if names[value.name] {
+			panic(fmt.Sprintf("enum value %s%s already exists", name, value.name))
+		}
+
+		reflection := reflect.ValueOf(value.value)
+
+		if !reflection.IsValid() || !(reflection.CanInt() || reflection.CanUint()) {
+			panic(fmt.Sprintf("enum value %s%s is not an integer", name, value.name))
+		}
+
+		converted := reflection.Convert(reciever)
+
+		if !converted.Convert(reflection.Type()).Equal(reflection) {
+			panic(fmt.Sprintf("enum value %s%s does not fit in %s", name, value.name, reciever))
+		}
+
+		literal := fmt.Sprintf("%d", converted.Interface())
+
+		if literals[literal] {
+			panic(fmt.Sprintf("enum value %s%s duplicates the value %s", name, value.name, literal))
+		}
+
+		names[value.name] = true
+		literals[literal] = true
+		enum.values = append(enum.values, value)
+		enum.literals = append(enum.literals, literal)
+	}
+
+	enums[name] = enum
+
+	cast := createCast(converter, reciever)
+	cast.enum = enum
+
+	return cast
+}
+
+func (c converterCast) Strict() converterCast {
+
+	if c.enum == nil {
+		panic("only enums can be strict")
+	}
+
+	c.strict = true
+	return c
+}
+
+func (e *packedEnum) fits(bits int) bool {
+
+	maximum := integerMaximum(e.underlying, bits)
+	signed := e.underlying.Kind() >= reflect.Int && e.underlying.Kind() <= reflect.Int64
+
+	for _, literal := range e.literals {
+
+		var value reflect.Value
+		var err error
+
+		if signed {
+			var integer int64
+			integer, err = strconv.ParseInt(literal, 10, 64)
+			value = reflect.ValueOf(integer)
+		} else {
+			var integer uint64
+			integer, err = strconv.ParseUint(literal, 10, 64)
+			value = reflect.ValueOf(integer)
+		}
+
+		if err != nil || !fitsInteger(value, e.underlying, maximum) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (e *packedEnum) writeStrictCheck(buffer *bytes.Buffer, structure *packedStruct, reciever string) {
+	fmt.Fprintf(buffer, "if !%s.IsValid() {\n", reciever)
+	fmt.Fprintf(buffer, "return 0, &packed.FieldError{Path: %q, Err: fmt.Errorf(\"%%w: unknown %s %%d\", packed.ErrInvalidValue, %s(%s))}\n", fieldPath(structure, reciever), e.name, e.underlying, reciever)
+	fmt.Fprintf(buffer, "}\n")
+}
+
+func (e *packedEnum) definition() []byte {
+
+	buffer := &bytes.Buffer{}
+
+	fmt.Fprintf(buffer, "type %s %s\n\n", e.name, e.underlying)
+
+	fmt.Fprintf(buffer, "const (\n")
+
+	for i, value := range e.values {
+		fmt.Fprintf(buffer, "%s%s %s = %s\n", e.name, value.name, e.name, e.literals[i])
+	}
+
+	fmt.Fprintf(buffer, ")\n\n")
+
+	fmt.Fprintf(buffer, "func (e %s) String() string {\n", e.name)
+	fmt.Fprintf(buffer, "switch e {\n")
+
+	for _, value := range e.values {
+		fmt.Fprintf(buffer, "case %s%s:\n", e.name, value.name)
+		fmt.Fprintf(buffer, "return %q\n", value.name)
+	}
+
+	fmt.Fprintf(buffer, "}\n")
+	fmt.Fprintf(buffer, "return fmt.Sprintf(\"%s(%%d)\", %s(e))\n", e.name, e.underlying)
+	fmt.Fprintf(buffer, "}\n\n")
+
+	fmt.Fprintf(buffer, "func (e %s) IsValid() bool {\n", e.name)
+	fmt.Fprintf(buffer, "switch e {\n")
+	fmt.Fprintf(buffer, "case ")
+
+	for i, value := range e.values {
+		if i > 0 {
+			fmt.Fprintf(buffer, ", ")
+		}
+		fmt.Fprintf(buffer, "%s%s", e.name, value.name)
+	}
+
+	fmt.Fprintf(buffer, ":\n")
+	fmt.Fprintf(buffer, "return true\n")
+	fmt.Fprintf(buffer, "}\n")
+	fmt.Fprintf(buffer, "return false\n")
+	fmt.Fprintf(buffer, "}\n\n")
+
+	fmt.Fprintf(buffer, "func Parse%s(value string) (%s, error) {\n", e.name, e.name)
+	fmt.Fprintf(buffer, "switch value {\n")
+
+	for _, value := range e.values {
+		fmt.Fprintf(buffer, "case %q:\n", value.name)
+		fmt.Fprintf(buffer, "return %s%s, nil\n", e.name, value.name)
+	}
+
+	fmt.Fprintf(buffer, "}\n")
+	fmt.Fprintf(buffer, "return 0, fmt.Errorf(\"%%w: %%q is not a valid %s\", packed.ErrInvalidValue, value)\n", e.name)
+	fmt.Fprintf(buffer, "}\n")
+
+	return buffer.Bytes()
+}
@@ -0,0 +1,18 @@
+package packed
+
+import "testing"
+
+func TestEnumFits(t *testing.T) {
+
+	signed := Enum("FitsSigned", Int8, EnumValue("Down", -2), EnumValue("Up", 1))
+
+	if !signed.enum.fits(2) || signed.enum.fits(1) {
+		t.Errorf("signed enum should fit in 2 bits but not in 1")
+	}
+
+	unsigned := Enum("FitsUnsigned", Uint8, EnumValue("Low", 1), EnumValue("High", 4))
+
+	if !unsigned.enum.fits(3) || unsigned.enum.fits(2) {
+		t.Errorf("unsigned enum should fit in 3 bits but not in 2")
+	}
+}
@@ -0,0 +1,30 @@
+package packed
+
+import (
+	"errors"
+)
+
+var (
+	ErrShortBuffer      = errors.New("packed: short buffer")
+	ErrInvalidLength    = errors.New("packed: invalid length")
+	ErrUnknownVariant   = errors.New("packed: unknown variant")
+	ErrInvalidPadding   = errors.New("packed: invalid padding")
+	ErrConstMismatch    = errors.New("packed: constant mismatch")
+	ErrChecksumMismatch = errors.New("packed: checksum mismatch")
+	ErrComputedMismatch = errors.New("packed: computed value mismatch")
+	ErrInvalidValue     = errors.New("packed: invalid value")
+	ErrOverflow         = errors.New("packed: value overflows field")
+)
+
+type FieldError struct {
+	Path string
+	Err  error
+}
+
+func (e *FieldError) Error() string {
+	return e.Path + ": " + e.Err.Error()
+}
+
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
@@ -17,6 +17,12 @@ const (
 	kindArray
 	kindBitField
 	kindBitFieldGroup
+	kindSlice
+	kindUnion
+	kindPadding
+	kindConst
+	kindChecksum
+	kindVariable
 )
 
 type structTag struct {
@@ -35,6 +41,12 @@ type packedProperty struct {
 	littleEndian   bool
 	endianOverride bool
 	converter      *converterHash
+	variable       bool
+	prepared       bool
+	when           string
+	align          int
+	computed       computedExpression
+	constraints    []fieldConstraint
 }
 
 type fieldOption func(*packedProperty)
@@ -45,6 +57,26 @@ func Tag(key, value string) fieldOption {
 	}
 }
 
+func When(flagField string) fieldOption {
+	return func(definition *packedProperty) {
+
+		switch definition.kind {
+		case kindBitField:
+			panic("bit fields cannot be optional")
+		case kindSlice:
+			panic("slices cannot be optional")
+		case kindChecksum:
+			panic("checksums cannot be optional")
+		}
+
+		if structure, ok := definition.packed.(packedStruct); ok && structure.checksummed {
+			panic(fmt.Sprintf("struct %s with checksums cannot be optional", structure.name))
+		}
+
+		definition.when = flagField
+	}
+}
+
 func (p *packedStruct) replacePropertiesWithClone() {
 	p.properties = slices.Clone(p.properties)
 
@@ -101,6 +133,22 @@ func validatePropertyType(propertyType any) (kind, reflect.Type, any) {
 		return kindBitField, nil, propertyType
 	}
 
+	if _, ok := propertyType.(packedSlice); ok {
+		return kindSlice, nil, propertyType
+	}
+
+	if _, ok := propertyType.(packedUnion); ok {
+		return kindUnion, nil, propertyType
+	}
+
+	if constant, ok := propertyType.(packedConst); ok {
+		return kindConst, constant.recieverType, propertyType
+	}
+
+	if checksum, ok := propertyType.(packedChecksum); ok {
+		return kindChecksum, checksum.recieverType, propertyType
+	}
+
 	if cast, ok := propertyType.(converterCast); ok {
 		return kindConverterCast, cast.target, propertyType
 	}
@@ -120,6 +168,10 @@ func validatePropertyType(propertyType any) (kind, reflect.Type, any) {
 		return kindConverter, reciever, propertyType
 	}
 
+	if reciever, ok := implementsVariableConverterInterface(propertyType); ok {
+		return kindVariable, reciever, propertyType
+	}
+
 	return kindInvalid, nil, propertyType
 }
 
@@ -146,7 +198,7 @@ func Field(name string, propertyType any, options ...fieldOption) packedProperty
 
 	property.propertyType = reflect.TypeOf(property.packed)
 
-	if property.kind == kindConverter {
+	if property.kind == kindConverter || property.kind == kindVariable {
 
 		hash := createConverterHash(property.packed)
 
@@ -161,6 +213,36 @@ func Field(name string, propertyType any, options ...fieldOption) packedProperty
 		property.size = property.packed.(interface{ Size() int }).Size()
 	}
 
+	switch packed := property.packed.(type) {
+	case packedStruct:
+		property.variable = packed.variable
+		property.prepared = packed.prepared
+	case packedSlice:
+		property.variable = true
+		property.prepared = true
+	case packedUnion:
+		property.variable = !packed.fixed
+		property.prepared = true
+	}
+
+	if property.kind == kindVariable {
+		property.variable = true
+	}
+
+	if property.when != "" {
+		property.variable = true
+		property.prepared = true
+	}
+
+	if property.computed.kind != computedNone {
+
+		if property.when != "" {
+			panic("computed fields cannot be optional")
+		}
+
+		property.prepared = true
+	}
+
 	imported[property.propertyType.PkgPath()] = true
 
 	if property.recieverType != nil {
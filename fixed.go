@@ -0,0 +1,143 @@
+package packed
+
+import (
+	"fmt"
+	"math"
+)
+
+var (
+	Q7  = FixedPoint(8, 7, true)
+	Q15 = FixedPoint(16, 15, true)
+	Q31 = FixedPoint(32, 31, true)
+)
+
+func FixedPoint(bits, fractionBits int, signed bool) FixedPointConverter {
+
+	if fractionBits < 0 || fractionBits > bits {
+		panic(fmt.Sprintf("fraction bits must be between 0 and %d, got %d", bits, fractionBits))
+	}
+
+	return newFixedPoint(bits, math.Ldexp(1, fractionBits), signed)
+}
+
+func Decimal(bits, digits int, signed bool) FixedPointConverter {
+
+	if digits < 0 || digits > 18 {
+		panic(fmt.Sprintf("decimal digits must be between 0 and 18, got %d", digits))
+	}
+
+	return newFixedPoint(bits, math.Pow10(digits), signed)
+}
+
+func newFixedPoint(bits int, scale float64, signed bool) FixedPointConverter {
+
+	if bits < 1 || bits > 64 {
+		panic(fmt.Sprintf("fixed point bits must be between 1 and 64, got %d", bits))
+	}
+
+	return FixedPointConverter{Bits: bits, Scale: scale, Signed: signed}
+}
+
+type FixedPointConverter struct {
+	Bits   int     `packed_hash_field:"bits"`
+	Scale  float64 `packed_hash_field:"scale"`
+	Signed bool    `packed_hash_field:"signed"`
+}
+
+func (f *FixedPointConverter) InitializeConverterFields() map[string]string {
+	return map[string]string{
+		"Bits":   fmt.Sprintf("%v", f.Bits),
+		"Scale":  fmt.Sprintf("%v", f.Scale),
+		"Signed": fmt.Sprintf("%v", f.Signed),
+	}
+}
+
+func (f *FixedPointConverter) Size() int { return (f.Bits + 7) / 8 }
+
+func (f *FixedPointConverter) bitWidth() int { return f.Bits }
+
+func (f *FixedPointConverter) mask() uint64 {
+	if f.Bits == 64 {
+		return math.MaxUint64
+	}
+	return uint64(1)<<f.Bits - 1
+}
+
+func (f *FixedPointConverter) Integer(value *float64) uint64 {
+
+	scaled := math.Round(*value * f.Scale)
+
+	if math.IsNaN(scaled) {
+		return 0
+	}
+
+	if !f.Signed {
+		switch {
+		case scaled <= 0:
+			return 0
+		case scaled >= math.Ldexp(1, f.Bits):
+			return f.mask()
+		}
+		return uint64(scaled)
+	}
+
+	limit := math.Ldexp(1, f.Bits-1)
+
+	switch {
+	case scaled >= limit:
+		return f.mask() >> 1
+	case scaled < -limit:
+		return (f.mask() >> 1) + 1
+	}
+
+	return uint64(int64(scaled)) & f.mask()
+}
+
+func (f *FixedPointConverter) Set(reciever *float64, integer uint64) {
+
+	integer &= f.mask()
+
+	if f.Signed && f.Bits < 64 && integer>>(f.Bits-1) != 0 {
+		*reciever = float64(int64(integer)-int64(1)<<f.Bits) / f.Scale
+		return
+	}
+
+	if f.Signed {
+		*reciever = float64(int64(integer)) / f.Scale
+		return
+	}
+
+	*reciever = float64(integer) / f.Scale
+}
+
+func (f *FixedPointConverter) ToBytesLittleEndian(value *float64, bytes []byte, index int) {
+	integer := f.Integer(value)
+	for i := 0; i < f.Size(); i++ {
+		bytes[index+i] = byte(integer >> (8 * i))
+	}
+}
+
+func (f *FixedPointConverter) FromBytesLittleEndian(reciever *float64, bytes []byte, index int) {
+	var integer uint64
+	for i := 0; i < f.Size(); i++ {
+		integer |= uint64(bytes[index+i]) << (8 * i)
+	}
+	f.Set(reciever, integer)
+}
+
+func (f *FixedPointConverter) ToBytesBigEndian(value *float64, bytes []byte, index int) {
+	integer := f.Integer(value)
+	size := f.Size()
+	for i := 0; i < size; i++ {
+		bytes[index+size-1-i] = byte(integer >> (8 * i))
+	}
+}
+
+func (f *FixedPointConverter) FromBytesBigEndian(reciever *float64, bytes []byte, index int) {
+	var integer uint64
+	size := f.Size()
+	for i := 0; i < size; i++ {
+		integer |= uint64(bytes[index+size-1-i]) << (8 * i)
+	}
+	f.Set(reciever, integer)
+}
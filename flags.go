@@ -0,0 +1,138 @@
+package packed
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+type packedFlags struct {
+	name       string
+	reflection reflect.Type
+	names      []string
+}
+
+func Flags(name string, width int, names ...string) packedBitField {
+
+	if _, ok := flagSets[name]; ok {
+		panic(fmt.Sprintf("flags %s already exists", name))
+	}
+
+	if _, ok := enums[name]; ok {
+		panic(fmt.Sprintf("flags %s conflicts with an enum of the same name", name))
+	}
+
+	if _, ok := fixedStrings[name]; ok {
+		panic(fmt.Sprintf("flags %s conflicts with a fixed string of the same name", name))
+	}
+
+	if width < 1 || width > 64 {
+		panic("flags width must be between 1 and 64 bits")
+	}
+
+	if len(names) > width {
+		panic(fmt.Sprintf("flags %s has %d names but only %d bits", name, len(names), width))
+	}
+
+	seen := map[string]bool{}
+
+	for _, flag := range names {
+
+		if flag == "" {
+			continue
+		}
+
+		if seen[flag] {
+			panic(fmt.Sprintf("flag %s%s already exists", name, flag))
+		}
+
+		seen[flag] = true
+	}
+
+	reflection := reflect.TypeOf(uint64(0))
+
+	switch {
+	case width <= 8:
+		reflection = reflect.TypeOf(uint8(0))
+	case width <= 16:
+		reflection = reflect.TypeOf(uint16(0))
+	case width <= 32:
+		reflection = reflect.TypeOf(uint32(0))
+	}
+
+	flags := &packedFlags{name: name, reflection: reflection, names: names}
+	flagSets[name] = flags
+
+	return packedBitField{
+		bitSize:      width,
+		reflection:   reflection,
+		bitFieldKind: bitFieldKindInteger,
+		flags:        flags,
+	}
+}
+
+func (f *packedFlags) definition() []byte {
+
+	buffer := &bytes.Buffer{}
+	constants := []string{}
+
+	fmt.Fprintf(buffer, "type %s %s\n\n", f.name, f.reflection)
+
+	fmt.Fprintf(buffer, "const (\n")
+
+	for bit, flag := range f.names {
+
+		if flag == "" {
+			continue
+		}
+
+		fmt.Fprintf(buffer, "%s%s %s = 1 << %d\n", f.name, flag, f.name, bit)
+		constants = append(constants, f.name+flag)
+	}
+
+	fmt.Fprintf(buffer, ")\n\n")
+
+	fmt.Fprintf(buffer, "func (f %s) Has(flag %s) bool {\n", f.name, f.name)
+	fmt.Fprintf(buffer, "return f&flag == flag\n")
+	fmt.Fprintf(buffer, "}\n\n")
+
+	fmt.Fprintf(buffer, "func (f *%s) Set(flag %s) {\n", f.name, f.name)
+	fmt.Fprintf(buffer, "*f |= flag\n")
+	fmt.Fprintf(buffer, "}\n\n")
+
+	fmt.Fprintf(buffer, "func (f *%s) Clear(flag %s) {\n", f.name, f.name)
+	fmt.Fprintf(buffer, "*f &^= flag\n")
+	fmt.Fprintf(buffer, "}\n\n")
+
+	fmt.Fprintf(buffer, "func (f %s) String() string {\n", f.name)
+	fmt.Fprintf(buffer, "if f == 0 {\n")
+	fmt.Fprintf(buffer, "return \"0\"\n")
+	fmt.Fprintf(buffer, "}\n")
+	fmt.Fprintf(buffer, "names := []string{}\n")
+
+	for _, flag := range f.names {
+
+		if flag == "" {
+			continue
+		}
+
+		fmt.Fprintf(buffer, "if f&%s%s != 0 {\n", f.name, flag)
+		fmt.Fprintf(buffer, "names = append(names, %q)\n", flag)
+		fmt.Fprintf(buffer, "}\n")
+	}
+
+	unknown := "f"
+
+	if len(constants) > 0 {
+		unknown = fmt.Sprintf("f &^ (%s)", strings.Join(constants, " | "))
+	}
+
+	fmt.Fprintf(buffer, "if unknown := %s; unknown != 0 {\n", unknown)
+	fmt.Fprintf(buffer, "names = append(names, fmt.Sprintf(\"0x%%X\", %s(unknown)))\n", f.reflection)
+	fmt.Fprintf(buffer, "}\n")
+	fmt.Fprintf(buffer, "return strings.Join(names, \"|\")\n")
+	fmt.Fprintf(buffer, "}\n")
+
+	return buffer.Bytes()
+}
@@ -0,0 +1,31 @@
+package packed
+
+import "testing"
+
+func TestFlagsAreNotIntegers(t *testing.T) {
+
+	variant := Struct("FlagsVariant", false, Field("A", Uint8))
+
+	tests := map[string]func(){
+		"length": func() {
+			Struct("FlagsLength", false, Field("Options", Flags("LengthOptions", 8, "A")), Field("Items", Slice("Options", Uint8)))
+		},
+		"discriminator": func() {
+			Struct("FlagsDiscriminator", false, Field("Options", Flags("DiscriminatorOptions", 8, "A")), Field("Payload", Union("Options", Variant(1, variant))))
+		},
+		"computed": func() {
+			Struct("FlagsComputed", false, Field("Options", Flags("ComputedOptions", 8, "A"), Computed(SizeOf(""))))
+		},
+	}
+
+	for name, test := range tests {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s: expected a panic", name)
+				}
+			}()
+			test()
+		}()
+	}
+}
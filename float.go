@@ -0,0 +1,272 @@
+package packed
+
+import (
+	"math"
+)
+
+var (
+	Float16    = Float16Converter{}
+	BFloat16   = BFloat16Converter{}
+	IBMFloat32 = IBMFloat32Converter{}
+	IBMFloat64 = IBMFloat64Converter{}
+)
+
+func float16Bits(value float32) uint16 {
+
+	bits := math.Float32bits(value)
+	sign := uint16(bits>>16) & 0x8000
+	exponent := int(bits>>23) & 0xFF
+	fraction := bits & 0x7FFFFF
+
+	if exponent == 0xFF {
+		if fraction == 0 {
+			return sign | 0x7C00
+		}
+		return sign | 0x7E00 | uint16(fraction>>13)
+	}
+
+	halfExponent := exponent - 127 + 15
+
+	if halfExponent >= 0x1F {
+		return sign | 0x7C00
+	}
+
+	if halfExponent <= 0 {
+		shift := uint(14 - halfExponent)
+
+		if shift > 24 {
+			return sign
+		}
+
+		mantissa := fraction | 0x800000
+		result := mantissa >> shift
+		remainder := mantissa & (1<<shift - 1)
+		half := uint32(1) << (shift - 1)
+
+		if remainder > half || (remainder == half && result&1 == 1) {
+			result++
+		}
+
+		return sign | uint16(result)
+	}
+
+	result := sign | uint16(halfExponent)<<10 | uint16(fraction>>13)
+	remainder := fraction & 0x1FFF
+
+	if remainder > 0x1000 || (remainder == 0x1000 && result&1 == 1) {
+		result++
+	}
+
+	return result
+}
+
+func float16Value(bits uint16) float32 {
+
+	sign := uint32(bits&0x8000) << 16
+	exponent := uint32(bits>>10) & 0x1F
+	fraction := uint32(bits & 0x3FF)
+
+	switch exponent {
+
+	case 0x1F:
+		return math.Float32frombits(sign | 0x7F800000 | fraction<<13)
+
+	case 0:
+		value := float32(math.Ldexp(float64(fraction), -24))
+
+		if sign != 0 {
+			return -value
+		}
+
+		return value
+	}
+
+	return math.Float32frombits(sign | (exponent-15+127)<<23 | fraction<<13)
+}
+
+type Float16Converter struct{}
+
+func (Float16Converter) Size() int { return 2 }
+
+func (Float16Converter) ToBytesLittleEndian(value *float32, bytes []byte, index int) {
+	bits := float16Bits(*value)
+	bytes[index] = byte(bits)
+	bytes[index+1] = byte(bits >> 8)
+}
+
+func (Float16Converter) FromBytesLittleEndian(receiver *float32, bytes []byte, index int) {
+	*receiver = float16Value(uint16(bytes[index]) | uint16(bytes[index+1])<<8)
+}
+
+func (Float16Converter) ToBytesBigEndian(value *float32, bytes []byte, index int) {
+	bits := float16Bits(*value)
+	bytes[index] = byte(bits >> 8)
+	bytes[index+1] = byte(bits)
+}
+
+func (Float16Converter) FromBytesBigEndian(receiver *float32, bytes []byte, index int) {
+	*receiver = float16Value(uint16(bytes[index])<<8 | uint16(bytes[index+1]))
+}
+
+func bfloat16Bits(value float32) uint16 {
+
+	bits := math.Float32bits(value)
+
+	if value != value {
+		return uint16(bits>>16) | 0x0040
+	}
+
+	return uint16((bits + 0x7FFF + (bits>>16)&1) >> 16)
+}
+
+func bfloat16Value(bits uint16) float32 {
+	return math.Float32frombits(uint32(bits) << 16)
+}
+
+type BFloat16Converter struct{}
+
+func (BFloat16Converter) Size() int { return 2 }
+
+func (BFloat16Converter) ToBytesLittleEndian(value *float32, bytes []byte, index int) {
+	bits := bfloat16Bits(*value)
+	bytes[index] = byte(bits)
+	bytes[index+1] = byte(bits >> 8)
+}
+
+func (BFloat16Converter) FromBytesLittleEndian(receiver *float32, bytes []byte, index int) {
+	*receiver = bfloat16Value(uint16(bytes[index]) | uint16(bytes[index+1])<<8)
+}
+
+func (BFloat16Converter) ToBytesBigEndian(value *float32, bytes []byte, index int) {
+	bits := bfloat16Bits(*value)
+	bytes[index] = byte(bits >> 8)
+	bytes[index+1] = byte(bits)
+}
+
+func (BFloat16Converter) FromBytesBigEndian(receiver *float32, bytes []byte, index int) {
+	*receiver = bfloat16Value(uint16(bytes[index])<<8 | uint16(bytes[index+1]))
+}
+
+func ibmBits(value float64, fractionBits int) uint64 {
+
+	sign := uint64(0)
+
+	if math.Signbit(value) {
+		sign = 1 << (fractionBits + 7)
+		value = -value
+	}
+
+	maximum := sign | uint64(0x7F)<<fractionBits | (uint64(1)<<fractionBits - 1)
+
+	switch {
+	case value != value:
+		return uint64(0x7F)<<fractionBits | (uint64(1)<<fractionBits - 1)
+	case math.IsInf(value, 0):
+		return maximum
+	case value == 0:
+		return sign
+	}
+
+	fraction, exponent := math.Frexp(value)
+	hexExponent := (exponent + 3) >> 2
+	mantissa := math.RoundToEven(math.Ldexp(fraction, fractionBits-(4*hexExponent-exponent)))
+
+	if mantissa >= math.Ldexp(1, fractionBits) {
+		mantissa = math.Ldexp(mantissa, -4)
+		hexExponent++
+	}
+
+	biased := hexExponent + 64
+
+	if biased > 0x7F {
+		return maximum
+	}
+
+	if biased < 0 {
+		mantissa = math.RoundToEven(math.Ldexp(value, fractionBits+256))
+		biased = 0
+	}
+
+	return sign | uint64(biased)<<fractionBits | uint64(mantissa)
+}
+
+func ibmValue(bits uint64, fractionBits int) float64 {
+
+	exponent := int(bits>>fractionBits) & 0x7F
+	fraction := bits & (uint64(1)<<fractionBits - 1)
+	value := math.Ldexp(float64(fraction), 4*(exponent-64)-fractionBits)
+
+	if bits>>(fractionBits+7)&1 == 1 {
+		return -value
+	}
+
+	return value
+}
+
+type IBMFloat32Converter struct{}
+
+func (IBMFloat32Converter) Size() int { return 4 }
+
+func (IBMFloat32Converter) ToBytesLittleEndian(value *float64, bytes []byte, index int) {
+	bits := ibmBits(*value, 24)
+	for i := 0; i < 4; i++ {
+		bytes[index+i] = byte(bits >> (8 * i))
+	}
+}
+
+func (IBMFloat32Converter) FromBytesLittleEndian(receiver *float64, bytes []byte, index int) {
+	var bits uint64
+	for i := 0; i < 4; i++ {
+		bits |= uint64(bytes[index+i]) << (8 * i)
+	}
+	*receiver = ibmValue(bits, 24)
+}
+
+func (IBMFloat32Converter) ToBytesBigEndian(value *float64, bytes []byte, index int) {
+	bits := ibmBits(*value, 24)
+	for i := 0; i < 4; i++ {
+		bytes[index+3-i] = byte(bits >> (8 * i))
+	}
+}
+
+func (IBMFloat32Converter) FromBytesBigEndian(receiver *float64, bytes []byte, index int) {
+	var bits uint64
+	for i := 0; i < 4; i++ {
+		bits |= uint64(bytes[index+3-i]) << (8 * i)
+	}
+	*receiver = ibmValue(bits, 24)
+}
+
+type IBMFloat64Converter struct{}
+
+func (IBMFloat64Converter) Size() int { return 8 }
+
+func (IBMFloat64Converter) ToBytesLittleEndian(value *float64, bytes []byte, index int) {
+	bits := ibmBits(*value, 56)
+	for i := 0; i < 8; i++ {
+		bytes[index+i] = byte(bits >> (8 * i))
+	}
+}
+
+func (IBMFloat64Converter) FromBytesLittleEndian(receiver *float64, bytes []byte, index int) {
+	var bits uint64
+	for i := 0; i < 8; i++ {
+		bits |= uint64(bytes[index+i]) << (8 * i)
+	}
+	*receiver = ibmValue(bits, 56)
+}
+
+func (IBMFloat64Converter) ToBytesBigEndian(value *float64, bytes []byte, index int) {
+	bits := ibmBits(*value, 56)
+	for i := 0; i < 8; i++ {
+		bytes[index+7-i] = byte(bits >> (8 * i))
+	}
+}
+
+func (IBMFloat64Converter) FromBytesBigEndian(receiver *float64, bytes []byte, index int) {
+	var bits uint64
+	for i := 0; i < 8; i++ {
+		bits |= uint64(bytes[index+7-i]) << (8 * i)
+	}
+	*receiver = ibmValue(bits, 56)
+}
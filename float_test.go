@@ -0,0 +1,274 @@
+package packed
+
+import (
+	"math"
+	"testing"
+)
+
+func float16Reference(bits uint16) float64 {
+	sign := 1.0
+	if bits&0x8000 != 0 {
+		sign = -1
+	}
+
+	exponent := int(bits>>10) & 0x1F
+	fraction := float64(bits & 0x3FF)
+
+	switch exponent {
+	case 0x1F:
+		if fraction != 0 {
+			return math.NaN()
+		}
+		return math.Inf(int(sign))
+	case 0:
+		return sign * math.Ldexp(fraction, -24)
+	}
+
+	return sign * math.Ldexp(1024+fraction, exponent-25)
+}
+
+func TestFloat16Converter(t *testing.T) {
+	converter := Float16Converter{}
+	bytes := make([]byte, 2)
+
+	for i := 0; i < 1<<16; i++ {
+		bits := uint16(i)
+		expected := float16Reference(bits)
+
+		bytes[0], bytes[1] = byte(bits), byte(bits>>8)
+		var resultLittleEndian float32
+		converter.FromBytesLittleEndian(&resultLittleEndian, bytes, 0)
+
+		bytes[0], bytes[1] = byte(bits>>8), byte(bits)
+		var resultBigEndian float32
+		converter.FromBytesBigEndian(&resultBigEndian, bytes, 0)
+
+		if math.IsNaN(expected) {
+			if resultLittleEndian == resultLittleEndian || resultBigEndian == resultBigEndian {
+				t.Errorf("Float16 %#04x: expected NaN, got %v and %v", bits, resultLittleEndian, resultBigEndian)
+			}
+
+			converter.ToBytesLittleEndian(&resultLittleEndian, bytes, 0)
+			encoded := uint16(bytes[0]) | uint16(bytes[1])<<8
+
+			if encoded&0x7C00 != 0x7C00 || encoded&0x3FF == 0 || encoded&0x8000 != bits&0x8000 || encoded&0x1FF != bits&0x1FF {
+				t.Errorf("Float16 %#04x: NaN encoded as %#04x", bits, encoded)
+			}
+			continue
+		}
+
+		if float64(resultLittleEndian) != expected || float64(resultBigEndian) != expected || math.Signbit(float64(resultLittleEndian)) != math.Signbit(expected) {
+			t.Errorf("Float16 %#04x: expected %v, got %v and %v", bits, expected, resultLittleEndian, resultBigEndian)
+		}
+
+		converter.ToBytesLittleEndian(&resultLittleEndian, bytes, 0)
+		if encoded := uint16(bytes[0]) | uint16(bytes[1])<<8; encoded != bits {
+			t.Errorf("Float16 ToBytesLittleEndian %v: expected %#04x, got %#04x", resultLittleEndian, bits, encoded)
+		}
+
+		converter.ToBytesBigEndian(&resultBigEndian, bytes, 0)
+		if encoded := uint16(bytes[0])<<8 | uint16(bytes[1]); encoded != bits {
+			t.Errorf("Float16 ToBytesBigEndian %v: expected %#04x, got %#04x", resultBigEndian, bits, encoded)
+		}
+
+		if bits&0x7FFF >= 0x7C00 {
+			continue
+		}
+
+		next := bits + 1
+		low, high := float16Value(bits), float16Value(next)
+
+		if bits&0x7FFF == 0x7BFF {
+			high = float32(math.Copysign(65536, expected))
+		}
+
+		even := bits
+		if bits&1 == 1 {
+			even = next
+		}
+
+		middle := float32((float64(low) + float64(high)) / 2)
+
+		if encoded := float16Bits(middle); encoded != even {
+			t.Errorf("Float16 midpoint %v: expected %#04x, got %#04x", middle, even, encoded)
+		}
+
+		below := math.Nextafter32(middle, low)
+		if encoded := float16Bits(below); encoded != bits {
+			t.Errorf("Float16 below midpoint %v: expected %#04x, got %#04x", below, bits, encoded)
+		}
+
+		above := math.Nextafter32(middle, high)
+		if encoded := float16Bits(above); encoded != next {
+			t.Errorf("Float16 above midpoint %v: expected %#04x, got %#04x", above, next, encoded)
+		}
+	}
+
+	values := map[float32]uint16{
+		1:                           0x3C00,
+		-2:                          0xC000,
+		65504:                       0x7BFF,
+		65520:                       0x7C00,
+		1e10:                        0x7C00,
+		float32(math.Inf(-1)):       0xFC00,
+		6.103515625e-05:             0x0400,
+		5.960464477539063e-08:       0x0001,
+		2.9802322387695312e-08:      0x0000,
+		2.9802326e-08:               0x0001,
+		-1e-30:                      0x8000,
+		0.333251953125:              0x3555,
+		math.SmallestNonzeroFloat32: 0x0000,
+	}
+
+	for value, expected := range values {
+		if encoded := float16Bits(value); encoded != expected {
+			t.Errorf("Float16 %v: expected %#04x, got %#04x", value, expected, encoded)
+		}
+	}
+}
+
+func TestBFloat16Converter(t *testing.T) {
+	converter := BFloat16Converter{}
+	bytes := make([]byte, 2)
+
+	for i := 0; i < 1<<16; i++ {
+		bits := uint16(i)
+		expected := math.Float32frombits(uint32(bits) << 16)
+
+		bytes[0], bytes[1] = byte(bits), byte(bits>>8)
+		var resultLittleEndian float32
+		converter.FromBytesLittleEndian(&resultLittleEndian, bytes, 0)
+
+		bytes[0], bytes[1] = byte(bits>>8), byte(bits)
+		var resultBigEndian float32
+		converter.FromBytesBigEndian(&resultBigEndian, bytes, 0)
+
+		if math.Float32bits(resultLittleEndian) != math.Float32bits(expected) || math.Float32bits(resultBigEndian) != math.Float32bits(expected) {
+			t.Errorf("BFloat16 %#04x: expected %v, got %v and %v", bits, expected, resultLittleEndian, resultBigEndian)
+		}
+
+		converter.ToBytesLittleEndian(&resultLittleEndian, bytes, 0)
+		encoded := uint16(bytes[0]) | uint16(bytes[1])<<8
+
+		if expected != expected {
+			if encoded&0x7F80 != 0x7F80 || encoded&0x7F == 0 || encoded&0x803F != bits&0x803F {
+				t.Errorf("BFloat16 %#04x: NaN encoded as %#04x", bits, encoded)
+			}
+			continue
+		}
+
+		if encoded != bits {
+			t.Errorf("BFloat16 ToBytesLittleEndian %v: expected %#04x, got %#04x", resultLittleEndian, bits, encoded)
+		}
+
+		converter.ToBytesBigEndian(&resultBigEndian, bytes, 0)
+		if encoded := uint16(bytes[0])<<8 | uint16(bytes[1]); encoded != bits {
+			t.Errorf("BFloat16 ToBytesBigEndian %v: expected %#04x, got %#04x", resultBigEndian, bits, encoded)
+		}
+
+		if bits&0x7FFF >= 0x7F80 {
+			continue
+		}
+
+		even := bits
+		if bits&1 == 1 {
+			even = bits + 1
+		}
+
+		middle := math.Float32frombits(uint32(bits)<<16 | 0x8000)
+
+		if encoded := bfloat16Bits(middle); encoded != even {
+			t.Errorf("BFloat16 midpoint %v: expected %#04x, got %#04x", middle, even, encoded)
+		}
+
+		if encoded := bfloat16Bits(math.Float32frombits(uint32(bits)<<16 | 0x7FFF)); encoded != bits {
+			t.Errorf("BFloat16 below midpoint of %#04x: got %#04x", bits, encoded)
+		}
+
+		if encoded := bfloat16Bits(math.Float32frombits(uint32(bits)<<16 | 0x8001)); encoded != bits+1 {
+			t.Errorf("BFloat16 above midpoint of %#04x: got %#04x", bits, encoded)
+		}
+	}
+}
+
+func TestIBMFloatConverter(t *testing.T) {
+	single := IBMFloat32Converter{}
+	double := IBMFloat64Converter{}
+
+	values := map[uint32]float64{
+		0x00000000: 0,
+		0x80000000: math.Copysign(0, -1),
+		0x41100000: 1,
+		0xC1100000: -1,
+		0x42640000: 100,
+		0xC276A000: -118.625,
+		0x40800000: 0.5,
+		0x3F200000: 0.0078125,
+		0x00100000: math.Ldexp(1, -260),
+		0x7FFFFFFF: math.Ldexp(1-math.Ldexp(1, -24), 252),
+		0x00000001: math.Ldexp(1, -280),
+	}
+
+	bytes := make([]byte, 8)
+
+	for bits, expected := range values {
+		bytes[0], bytes[1], bytes[2], bytes[3] = byte(bits>>24), byte(bits>>16), byte(bits>>8), byte(bits)
+
+		var result float64
+		single.FromBytesBigEndian(&result, bytes, 0)
+		if result != expected || math.Signbit(result) != math.Signbit(expected) {
+			t.Errorf("IBMFloat32 %#08x: expected %v, got %v", bits, expected, result)
+		}
+
+		if bits == 0x00000001 {
+			continue
+		}
+
+		single.ToBytesLittleEndian(&expected, bytes, 0)
+		if encoded := uint32(bytes[0]) | uint32(bytes[1])<<8 | uint32(bytes[2])<<16 | uint32(bytes[3])<<24; encoded != bits {
+			t.Errorf("IBMFloat32 %v: expected %#08x, got %#08x", expected, bits, encoded)
+		}
+
+		double.ToBytesBigEndian(&expected, bytes, 0)
+		double.FromBytesBigEndian(&result, bytes, 0)
+		if result != expected || math.Signbit(result) != math.Signbit(expected) {
+			t.Errorf("IBMFloat64 %v: got %v", expected, result)
+		}
+
+		encoded := uint64(bytes[0])<<56 | uint64(bytes[1])<<48 | uint64(bytes[2])<<40 | uint64(bytes[3])<<32
+		if encoded != uint64(bits)<<32 && bits != 0x7FFFFFFF {
+			t.Errorf("IBMFloat64 %v: expected %#08x00000000, got %#016x", expected, bits, encoded)
+		}
+	}
+
+	saturated := map[float64]uint32{
+		math.Inf(1):            0x7FFFFFFF,
+		math.Inf(-1):           0xFFFFFFFF,
+		math.NaN():             0x7FFFFFFF,
+		1e100:                  0x7FFFFFFF,
+		-1e100:                 0xFFFFFFFF,
+		1e-100:                 0x00000000,
+		math.Ldexp(1.5, -280):  0x00000002,
+		math.Ldexp(2.5, -280):  0x00000002,
+		1 + math.Ldexp(1, -21): 0x41100000,
+		1 + math.Ldexp(3, -21): 0x41100002,
+		1 - math.Ldexp(1, -26): 0x41100000,
+		math.Pi:                0x413243F7,
+	}
+
+	for value, expected := range saturated {
+		single.ToBytesBigEndian(&value, bytes, 0)
+		if encoded := uint32(bytes[0])<<24 | uint32(bytes[1])<<16 | uint32(bytes[2])<<8 | uint32(bytes[3]); encoded != expected {
+			t.Errorf("IBMFloat32 %v: expected %#08x, got %#08x", value, expected, encoded)
+		}
+	}
+
+	for _, value := range []float64{math.Pi, -math.E, 1e-70, 1e70, 0.1, 123456789.123456789} {
+		double.ToBytesLittleEndian(&value, bytes, 0)
+		var result float64
+		double.FromBytesLittleEndian(&result, bytes, 0)
+		if result != value {
+			t.Errorf("IBMFloat64 %v: got %v", value, result)
+		}
+	}
+}
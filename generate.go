@@ -3,22 +3,279 @@ package packed
 import (
 	"bytes"
 	"fmt"
-	"reflect"
 	"strings"
+	"text/tabwriter"
 )
 
+type propertyOffset struct {
+	constant int
+	minimum  int
+	reserved int
+}
+
+func (o *propertyOffset) remaining(structure *packedStruct) int {
+	return structure.size - o.minimum + o.reserved
+}
+
+func (o *propertyOffset) add(size int) {
+	o.constant += size
+	o.minimum += size
+}
+
+func (o *propertyOffset) flush(buffer *bytes.Buffer) {
+	if o.constant != 0 {
+		fmt.Fprintf(buffer, "index += %d\n", o.constant)
+	}
+	o.constant = 0
+}
+
 func (p *packedStruct) sizeDefinition() []byte {
 	buffer := &bytes.Buffer{}
 	fmt.Fprintf(buffer, "func (reciever *%s) Size() int {\n", p.name)
-	fmt.Fprintf(buffer, "return %d\n", p.size)
+
+	if !p.variable {
+		fmt.Fprintf(buffer, "return %d\n", p.size)
+		fmt.Fprintf(buffer, "}\n")
+		return buffer.Bytes()
+	}
+
+	fmt.Fprintf(buffer, "size := %d\n", p.size)
+
+	for _, property := range p.properties {
+		property.writeSize(buffer, "reciever")
+	}
+
+	fmt.Fprintf(buffer, "return size\n")
 	fmt.Fprintf(buffer, "}\n")
 	return buffer.Bytes()
 }
 
+func (p *packedProperty) writeSize(buffer *bytes.Buffer, recieverPrefix string) {
+
+	if !p.variable {
+		return
+	}
+
+	reciever := recieverPrefix + "." + p.name
+
+	if p.when != "" {
+		p.writeOptionalSize(buffer, reciever)
+		return
+	}
+
+	switch p.kind {
+
+	case kindStruct:
+		for _, child := range p.packed.(packedStruct).properties {
+			child.writeSize(buffer, reciever)
+		}
+
+	case kindSlice:
+		p.packed.(packedSlice).writeSize(buffer, reciever)
+
+	case kindUnion:
+		p.packed.(packedUnion).writeSize(buffer, reciever)
+
+	case kindVariable:
+		p.writeVariableSize(buffer, reciever)
+	}
+}
+
+func (p *packedProperty) writePrepare(buffer *bytes.Buffer, structure *packedStruct, recieverPrefix string) {
+
+	if !p.prepared {
+		return
+	}
+
+	reciever := recieverPrefix + "." + p.name
+
+	if p.computed.kind != computedNone {
+		p.computed.write(buffer, structure, recieverPrefix, reciever, "ToBytes")
+		return
+	}
+
+	if p.when != "" {
+		p.writeOptionalPrepare(buffer, structure, recieverPrefix, reciever)
+		return
+	}
+
+	switch p.kind {
+
+	case kindStruct:
+		for _, child := range p.packed.(packedStruct).properties {
+			child.writePrepare(buffer, structure, reciever)
+		}
+
+	case kindSlice:
+		slice := p.packed.(packedSlice)
+		slice.writePrepare(buffer, structure, reciever, recieverPrefix+"."+slice.lengthField)
+
+	case kindUnion:
+		union := p.packed.(packedUnion)
+		union.writePrepare(buffer, structure, reciever, recieverPrefix+"."+union.discriminatorField)
+
+	case kindBitFieldGroup:
+		for _, field := range p.packed.(packedBitFieldGroup).fields {
+			if field.packedProperty.computed.kind != computedNone {
+				field.packedProperty.computed.write(buffer, structure, recieverPrefix, recieverPrefix+"."+field.packedProperty.name, "ToBytes")
+			}
+		}
+	}
+}
+
+func (p *packedProperty) goType() string {
+
+	switch p.kind {
+
+	case kindStruct:
+		return p.packed.(packedStruct).name
+
+	case kindConverter:
+
+		if overwrite, ok := p.packed.(OverwriteConverterReciverReflectionInterface); ok {
+			return overwrite.OverwriteConverterReciverReflection(p.recieverType).String()
+		}
+
+		return p.recieverType.String()
+
+	case kindConverterCast:
+		return p.packed.(converterCast).targetName()
+
+	case kindType:
+		return p.propertyType.Elem().String()
+
+	case kindArray:
+		return p.packed.(packedArray).recieverType
+
+	case kindSlice:
+		return p.packed.(packedSlice).recieverType
+
+	case kindUnion:
+		return p.packed.(packedUnion).name
+
+	case kindChecksum, kindVariable:
+		return p.recieverType.String()
+
+	default:
+		panic("invalid property kind")
+	}
+}
+
+func (p *packedStruct) layoutDefinition() []byte {
+
+	buffer := &bytes.Buffer{}
+
+	endian := "little endian"
+
+	if !p.littleEndian {
+		endian = "big endian"
+	}
+
+	size := fmt.Sprintf("%d bytes", p.size)
+
+	if p.variable {
+		size = "at least " + size
+	}
+
+	fmt.Fprintf(buffer, "// %s is %s, %s, with %d byte alignment.\n", p.name, size, endian, p.alignment)
+	fmt.Fprintf(buffer, "//\n")
+
+	table := &bytes.Buffer{}
+	writer := tabwriter.NewWriter(table, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintf(writer, "offset\tsize\tfield\n")
+
+	offset := 0
+	variable := false
+
+	for _, property := range p.properties {
+
+		offsetString := fmt.Sprintf("%d", offset)
+
+		if variable {
+			offsetString += "+"
+		}
+
+		sizeString := fmt.Sprintf("%d", property.size)
+		name := property.name
+
+		switch property.kind {
+
+		case kindPadding:
+			name = "(padding)"
+
+		case kindConst:
+			name += fmt.Sprintf(" (const %#v)", property.packed.(packedConst).value.Interface())
+
+		case kindChecksum:
+			checksum := property.packed.(packedChecksum)
+			from, to := checksum.from, checksum.to
+
+			if from == "" {
+				from = "start"
+			}
+
+			if to == "" {
+				to = "here"
+			}
+
+			name += fmt.Sprintf(" (checksum of %s..%s)", from, to)
+
+		case kindBitFieldGroup:
+			names := []string{}
+
+			for _, field := range property.packed.(packedBitFieldGroup).fields {
+				if field.bitFieldKind == bitFieldKindReserved {
+					names = append(names, fmt.Sprintf("(reserved %d bits)", field.bitSize))
+				} else {
+					names = append(names, fmt.Sprintf("%s (%d bits)", field.packedProperty.name, field.bitSize))
+				}
+
+				if field.packedProperty.computed.kind != computedNone {
+					names[len(names)-1] += fmt.Sprintf(" (%s)", field.packedProperty.computed)
+				}
+			}
+
+			name = strings.Join(names, ", ")
+		}
+
+		if property.computed.kind != computedNone {
+			name += fmt.Sprintf(" (%s)", property.computed)
+		}
+
+		if property.variable && property.kind != kindStruct {
+			sizeString = "variable"
+		}
+
+		if property.when != "" {
+			sizeString = fmt.Sprintf("0 or %d", property.size)
+			name += " (when " + property.when + ")"
+		}
+
+		fmt.Fprintf(writer, "%s\t%s\t%s\n", offsetString, sizeString, name)
+
+		if property.when == "" {
+			offset += property.size
+		}
+
+		variable = variable || property.variable
+	}
+
+	writer.Flush()
+
+	for _, line := range strings.Split(strings.TrimSuffix(table.String(), "\n"), "\n") {
+		fmt.Fprintf(buffer, "//\t%s\n", strings.TrimRight(line, " "))
+	}
+
+	return buffer.Bytes()
+}
+
 func (p *packedStruct) structDefinition() []byte {
 
 	buffer := &bytes.Buffer{}
 
+	buffer.Write(p.layoutDefinition())
+
 	fmt.Fprintf(buffer, "type %s struct {\n", p.name)
 
 	for _, property := range p.properties {
@@ -35,36 +292,17 @@ func (p *packedStruct) structDefinition() []byte {
 			tagString = "`" + strings.Join(tags, " ") + "`"
 		}
 
-		var propertyType string
-
 		switch property.kind {
 
-		case kindStruct:
-			propertyType = property.packed.(packedStruct).name
-
-		case kindConverter:
-
-			if overwrite, ok := property.packed.(OverwriteConverterReciverReflectionInterface); ok {
-				propertyType = overwrite.OverwriteConverterReciverReflection(property.recieverType).String()
-
-			} else {
-				propertyType = property.recieverType.String()
-			}
-
-		case kindConverterCast:
-			propertyType = property.packed.(converterCast).target.String()
-
-		case kindType:
-			propertyType = property.propertyType.Elem().String()
-
-		case kindArray:
-			propertyType = property.packed.(packedArray).recieverType
-
 		case kindBitFieldGroup:
 			group := property.packed.(packedBitFieldGroup)
 
 			for _, field := range group.fields {
 
+				if field.bitFieldKind == bitFieldKindReserved {
+					continue
+				}
+
 				property := field.packedProperty
 
 				tags := []string{}
@@ -79,24 +317,30 @@ func (p *packedStruct) structDefinition() []byte {
 					tagString = "`" + strings.Join(tags, " ") + "`"
 				}
 
-				var reflection reflect.Type
+				var typeName string
 
 				switch field.bitFieldKind {
 
 				case bitFieldKindBitsType, bitFieldKindBitsConverter:
-					reflection = field.bitsTargetReflection.Elem()
+					typeName = field.bitsTargetReflection.Elem().String()
 
 				default:
-					reflection = field.reflection
+					typeName = field.typeName()
 				}
 
-				fmt.Fprintf(buffer, "%s %s %s\n", property.name, reflection, tagString)
+				fmt.Fprintf(buffer, "%s %s %s\n", property.name, typeName, tagString)
 			}
 
 			continue
 
-		default:
-			panic("invalid property kind")
+		case kindPadding, kindConst:
+			continue
+		}
+
+		propertyType := property.goType()
+
+		if property.when != "" {
+			propertyType = "*" + propertyType
 		}
 
 		fmt.Fprintf(buffer, "%s %s %s\n", property.name, propertyType, tagString)
@@ -104,79 +348,182 @@ func (p *packedStruct) structDefinition() []byte {
 
 	fmt.Fprintf(buffer, "}\n")
 
+	for _, property := range p.properties {
+
+		switch property.kind {
+
+		case kindUnion:
+			fmt.Fprintf(buffer, "\n")
+			buffer.Write(property.packed.(packedUnion).interfaceDefinition())
+
+		case kindConst:
+			fmt.Fprintf(buffer, "\n")
+			buffer.Write(property.packed.(packedConst).getterDefinition(p.name, property.name))
+		}
+	}
+
 	return buffer.Bytes()
 }
 
-func (p *packedProperty) writeProperty(buffer *bytes.Buffer, structure *packedStruct, functionName, recieverPrefix string, offset *int) {
+func (p *packedProperty) writeProperty(buffer *bytes.Buffer, structure *packedStruct, functionName, recieverPrefix string, offset *propertyOffset) {
+
+	reciever := recieverPrefix + "." + p.name
+
+	if p.when != "" {
+		p.writeOptional(buffer, structure, functionName, recieverPrefix, reciever, offset)
+		return
+	}
+
+	p.writeValue(buffer, structure, functionName, recieverPrefix, reciever, offset)
+}
+
+func writeConverterCall(buffer *bytes.Buffer, structure *packedStruct, converter converterHash, methodName, reciever, pathReciever, offset string) {
+
+	call := fmt.Sprintf("%s.%s(&%s, bytes, %s)", getConverterName(converter.hash), methodName, reciever, offset)
+
+	if !converterReturnsError(converter.instance, methodName) {
+		fmt.Fprintf(buffer, "%s\n", call)
+		return
+	}
+
+	fmt.Fprintf(buffer, "if err := %s; err != nil {\n", call)
+	fmt.Fprintf(buffer, "return 0, &packed.FieldError{Path: %s, Err: err}\n", fieldPathExpression(structure, pathReciever))
+	fmt.Fprintf(buffer, "}\n")
+}
+
+func (p *packedProperty) writeValue(buffer *bytes.Buffer, structure *packedStruct, functionName, recieverPrefix, reciever string, offset *propertyOffset) {
 	endian := "LittleEndian"
 
 	if !p.littleEndian {
 		endian = "BigEndian"
 	}
 
-	reciever := recieverPrefix + "." + p.name
-
 	switch p.kind {
 
 	case kindStruct:
-		for _, child := range p.packed.(packedStruct).properties {
-			child.writeProperty(buffer, structure, functionName, reciever, offset)
-		}
+		child := p.packed.(packedStruct)
+		child.writeProperties(buffer, structure, functionName, reciever, offset)
 		return
 
 	case kindConverter:
-		fmt.Fprintf(buffer, "%s.%s%s(&%s, bytes, index + %d)\n", getConverterName(p.converter.hash), functionName, endian, reciever, *offset)
+		writeConverterCall(buffer, structure, *p.converter, functionName+endian, reciever, reciever, fmt.Sprintf("index + %d", offset.constant))
 
 	case kindConverterCast:
 		cast := p.packed.(converterCast)
-		cast.Write(buffer, structure, reciever, functionName, p.littleEndian, fmt.Sprintf("index + %d", *offset))
-		*offset += cast.size
+		cast.Write(buffer, structure, reciever, functionName, p.littleEndian, fmt.Sprintf("index + %d", offset.constant))
+		offset.add(cast.size)
 		return
 
 	case kindArray:
 		array := p.packed.(packedArray)
-		fmt.Fprintf(buffer, "o%d := index + %d\n", *offset, *offset)
-		array.write(buffer, structure, reciever, functionName, p.littleEndian, fmt.Sprintf("o%d", *offset), 0)
-		*offset += p.size
+		fmt.Fprintf(buffer, "o%d := index + %d\n", offset.minimum, offset.constant)
+		array.write(buffer, structure, reciever, functionName, p.littleEndian, fmt.Sprintf("o%d", offset.minimum), 0)
+		offset.add(p.size)
+		return
+
+	case kindSlice:
+		slice := p.packed.(packedSlice)
+		offset.flush(buffer)
+		slice.write(buffer, structure, reciever, recieverPrefix+"."+slice.lengthField, functionName, p.littleEndian, offset.remaining(structure))
+		return
+
+	case kindUnion:
+		union := p.packed.(packedUnion)
+		union.write(buffer, structure, reciever, recieverPrefix+"."+union.discriminatorField, functionName, offset)
+		return
+
+	case kindPadding:
+		p.packed.(packedPadding).write(buffer, structure, recieverPrefix, functionName, fmt.Sprintf("index + %d", offset.constant))
+
+	case kindConst:
+		p.packed.(packedConst).write(buffer, structure, reciever, functionName, p.littleEndian, fmt.Sprintf("index + %d", offset.constant))
+
+	case kindChecksum:
+		p.packed.(packedChecksum).write(buffer, reciever, functionName, p.littleEndian, fmt.Sprintf("index + %d", offset.constant))
+
+	case kindVariable:
+		p.writeVariable(buffer, structure, functionName, reciever, offset)
 		return
 
 	case kindBitFieldGroup:
 		group := p.packed.(packedBitFieldGroup)
 
-		offsetString := fmt.Sprintf("index + %d", *offset)
+		offsetString := fmt.Sprintf("index + %d", offset.constant)
 
 		switch functionName {
 		case "ToBytes":
-			group.writeToBytes(buffer, reciever, p.littleEndian, offsetString)
+			group.writeToBytes(buffer, structure, reciever, p.littleEndian, offsetString)
 		case "FromBytes":
-			group.writeFromBytes(buffer, reciever, p.littleEndian, offsetString)
+			group.writeFromBytes(buffer, structure, reciever, p.littleEndian, offsetString)
 		default:
 			panic("invalid function name")
 		}
 
-		*offset += group.size
+		offset.add(group.size)
 		return
 
 	default:
-		fmt.Fprintf(buffer, "%s.%s%s(bytes, index + %d)\n", reciever, functionName, endian, *offset)
+		fmt.Fprintf(buffer, "%s.%s%s(bytes, index + %d)\n", reciever, functionName, endian, offset.constant)
 	}
 
-	*offset += p.size
+	offset.add(p.size)
+}
+
+func (p *packedStruct) writeProperties(buffer *bytes.Buffer, structure *packedStruct, functionName, recieverPrefix string, offset *propertyOffset) {
+	for i, property := range p.properties {
+		p.writeChecksumMarkers(buffer, structure, functionName, recieverPrefix, i, false, offset)
+		property.writeProperty(buffer, structure, functionName, recieverPrefix, offset)
+		p.writeChecksumMarkers(buffer, structure, functionName, recieverPrefix, i, true, offset)
+	}
 }
 
 func (p *packedStruct) conversionDefinition(functionName string) []byte {
 
 	buffer := &bytes.Buffer{}
 
-	fmt.Fprintf(buffer, "func (reciever *%s) %s(bytes []byte, index int) {\n", p.name, functionName)
-	offset := 0
+	fmt.Fprintf(buffer, "func (reciever *%s) %s(bytes []byte, index int) (int, error) {\n", p.name, functionName)
+	offset := &propertyOffset{}
+
+	if p.prepared && functionName == "ToBytes" {
+		for _, property := range p.properties {
+			property.writePrepare(buffer, p, "reciever")
+		}
+	}
+
+	if p.variable && functionName == "ToBytes" {
+		fmt.Fprintf(buffer, "if len(bytes) < index+reciever.Size() {\n")
+	} else {
+		fmt.Fprintf(buffer, "if len(bytes) < index+%d {\n", p.size)
+	}
+
+	fmt.Fprintf(buffer, "return 0, packed.ErrShortBuffer\n")
+	fmt.Fprintf(buffer, "}\n")
+
+	if p.variable {
+		fmt.Fprintf(buffer, "start := index\n")
+	}
 
 	for reciever, index := range p.converterCastRecievers {
 		fmt.Fprintf(buffer, "var r%d %s\n", index, reciever)
 	}
 
-	for _, property := range p.properties {
-		property.writeProperty(buffer, p, functionName, "reciever", &offset)
+	p.writeProperties(buffer, p, functionName, "reciever", offset)
+
+	if p.checksummed {
+		p.writeChecksums(buffer, p, functionName, "reciever")
+	}
+
+	if p.verified && functionName == "FromBytes" {
+		for _, property := range p.properties {
+			property.writeComputedChecks(buffer, p, "reciever")
+		}
+	}
+
+	if p.variable {
+		offset.flush(buffer)
+		fmt.Fprintf(buffer, "return index - start, nil\n")
+	} else {
+		fmt.Fprintf(buffer, "return %d, nil\n", p.size)
 	}
 
 	fmt.Fprintf(buffer, "}\n")
@@ -0,0 +1,266 @@
+package packed
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"slices"
+)
+
+var (
+	Uint128 = Uint128Converter{}
+	Int128  = Int128Converter{}
+)
+
+type U128 struct {
+	Hi, Lo uint64
+}
+
+func U128From64(value uint64) U128 {
+	return U128{Lo: value}
+}
+
+func U128FromBig(value *big.Int) (U128, error) {
+
+	if value.Sign() < 0 || value.BitLen() > 128 {
+		return U128{}, fmt.Errorf("%w: %s does not fit in 128 unsigned bits", ErrOverflow, value)
+	}
+
+	lo := new(big.Int).And(value, new(big.Int).SetUint64(math.MaxUint64))
+	hi := new(big.Int).Rsh(value, 64)
+
+	return U128{Hi: hi.Uint64(), Lo: lo.Uint64()}, nil
+}
+
+func (u U128) Big() *big.Int {
+	value := new(big.Int).SetUint64(u.Hi)
+	value.Lsh(value, 64)
+	return value.Or(value, new(big.Int).SetUint64(u.Lo))
+}
+
+func (u U128) String() string {
+	return u.Big().String()
+}
+
+type I128 struct {
+	Hi, Lo uint64
+}
+
+func I128From64(value int64) I128 {
+	return I128{Hi: uint64(value >> 63), Lo: uint64(value)}
+}
+
+func I128FromBig(value *big.Int) (I128, error) {
+
+	magnitude := value
+
+	if value.Sign() < 0 {
+		magnitude = new(big.Int).Not(value)
+	}
+
+	if magnitude.BitLen() > 127 {
+		return I128{}, fmt.Errorf("%w: %s does not fit in 128 signed bits", ErrOverflow, value)
+	}
+
+	if value.Sign() < 0 {
+		value = new(big.Int).Add(value, new(big.Int).Lsh(big.NewInt(1), 128))
+	}
+
+	unsigned, err := U128FromBig(value)
+
+	return I128(unsigned), err
+}
+
+func (i I128) Big() *big.Int {
+	value := U128(i).Big()
+
+	if i.Hi>>63 == 1 {
+		value.Sub(value, new(big.Int).Lsh(big.NewInt(1), 128))
+	}
+
+	return value
+}
+
+func (i I128) String() string {
+	return i.Big().String()
+}
+
+func putUint128LittleEndian(hi, lo uint64, bytes []byte, index int) {
+	for i := 0; i < 8; i++ {
+		bytes[index+i] = byte(lo >> (8 * i))
+		bytes[index+8+i] = byte(hi >> (8 * i))
+	}
+}
+
+func uint128LittleEndian(bytes []byte, index int) (hi, lo uint64) {
+	for i := 0; i < 8; i++ {
+		lo |= uint64(bytes[index+i]) << (8 * i)
+		hi |= uint64(bytes[index+8+i]) << (8 * i)
+	}
+	return hi, lo
+}
+
+func putUint128BigEndian(hi, lo uint64, bytes []byte, index int) {
+	for i := 0; i < 8; i++ {
+		bytes[index+7-i] = byte(hi >> (8 * i))
+		bytes[index+15-i] = byte(lo >> (8 * i))
+	}
+}
+
+func uint128BigEndian(bytes []byte, index int) (hi, lo uint64) {
+	for i := 0; i < 8; i++ {
+		hi |= uint64(bytes[index+7-i]) << (8 * i)
+		lo |= uint64(bytes[index+15-i]) << (8 * i)
+	}
+	return hi, lo
+}
+
+type Uint128Converter struct{}
+
+func (Uint128Converter) Size() int { return 16 }
+
+func (Uint128Converter) ToBytesLittleEndian(value *U128, bytes []byte, index int) {
+	putUint128LittleEndian(value.Hi, value.Lo, bytes, index)
+}
+
+func (Uint128Converter) FromBytesLittleEndian(receiver *U128, bytes []byte, index int) {
+	receiver.Hi, receiver.Lo = uint128LittleEndian(bytes, index)
+}
+
+func (Uint128Converter) ToBytesBigEndian(value *U128, bytes []byte, index int) {
+	putUint128BigEndian(value.Hi, value.Lo, bytes, index)
+}
+
+func (Uint128Converter) FromBytesBigEndian(receiver *U128, bytes []byte, index int) {
+	receiver.Hi, receiver.Lo = uint128BigEndian(bytes, index)
+}
+
+type Int128Converter struct{}
+
+func (Int128Converter) Size() int { return 16 }
+
+func (Int128Converter) ToBytesLittleEndian(value *I128, bytes []byte, index int) {
+	putUint128LittleEndian(value.Hi, value.Lo, bytes, index)
+}
+
+func (Int128Converter) FromBytesLittleEndian(receiver *I128, bytes []byte, index int) {
+	receiver.Hi, receiver.Lo = uint128LittleEndian(bytes, index)
+}
+
+func (Int128Converter) ToBytesBigEndian(value *I128, bytes []byte, index int) {
+	putUint128BigEndian(value.Hi, value.Lo, bytes, index)
+}
+
+func (Int128Converter) FromBytesBigEndian(receiver *I128, bytes []byte, index int) {
+	receiver.Hi, receiver.Lo = uint128BigEndian(bytes, index)
+}
+
+func BigInt(bytes int) BigIntConverter {
+	return newBigInt(bytes, true)
+}
+
+func BigUint(bytes int) BigIntConverter {
+	return newBigInt(bytes, false)
+}
+
+func newBigInt(bytes int, signed bool) BigIntConverter {
+
+	if bytes < 1 {
+		panic(fmt.Sprintf("big integer bytes must be at least 1, got %d", bytes))
+	}
+
+	return BigIntConverter{Bytes: bytes, Signed: signed}
+}
+
+type BigIntConverter struct {
+	Bytes  int  `packed_hash_field:"bytes"`
+	Signed bool `packed_hash_field:"signed"`
+}
+
+func (c *BigIntConverter) InitializeConverterFields() map[string]string {
+	return map[string]string{
+		"Bytes":  fmt.Sprintf("%d", c.Bytes),
+		"Signed": fmt.Sprintf("%v", c.Signed),
+	}
+}
+
+func (c *BigIntConverter) Size() int { return c.Bytes }
+
+func (c *BigIntConverter) encode(value *big.Int, bytes []byte, index int) error {
+
+	target := bytes[index : index+c.Bytes]
+
+	if value == nil || value.Sign() == 0 {
+		clear(target)
+		return nil
+	}
+
+	bits := 8 * c.Bytes
+
+	if !c.Signed {
+		if value.Sign() < 0 || value.BitLen() > bits {
+			return fmt.Errorf("%w: %s does not fit in %d unsigned bytes", ErrOverflow, value, c.Bytes)
+		}
+
+		value.FillBytes(target)
+		return nil
+	}
+
+	if value.Sign() > 0 {
+		if value.BitLen() > bits-1 {
+			return fmt.Errorf("%w: %s does not fit in %d signed bytes", ErrOverflow, value, c.Bytes)
+		}
+
+		value.FillBytes(target)
+		return nil
+	}
+
+	complement := new(big.Int).Not(value)
+
+	if complement.BitLen() > bits-1 {
+		return fmt.Errorf("%w: %s does not fit in %d signed bytes", ErrOverflow, value, c.Bytes)
+	}
+
+	complement.FillBytes(target)
+
+	for i := range target {
+		target[i] = ^target[i]
+	}
+
+	return nil
+}
+
+func (c *BigIntConverter) decode(receiver **big.Int, source []byte) {
+
+	value := new(big.Int).SetBytes(source)
+
+	if c.Signed && source[0]&0x80 != 0 {
+		value.Sub(value, new(big.Int).Lsh(big.NewInt(1), uint(8*c.Bytes)))
+	}
+
+	*receiver = value
+}
+
+func (c *BigIntConverter) ToBytesLittleEndian(value **big.Int, bytes []byte, index int) error {
+
+	if err := c.encode(*value, bytes, index); err != nil {
+		return err
+	}
+
+	slices.Reverse(bytes[index : index+c.Bytes])
+	return nil
+}
+
+func (c *BigIntConverter) FromBytesLittleEndian(receiver **big.Int, bytes []byte, index int) {
+	source := slices.Clone(bytes[index : index+c.Bytes])
+	slices.Reverse(source)
+	c.decode(receiver, source)
+}
+
+func (c *BigIntConverter) ToBytesBigEndian(value **big.Int, bytes []byte, index int) error {
+	return c.encode(*value, bytes, index)
+}
+
+func (c *BigIntConverter) FromBytesBigEndian(receiver **big.Int, bytes []byte, index int) {
+	c.decode(receiver, bytes[index:index+c.Bytes])
+}
@@ -0,0 +1,172 @@
+package packed
+
+import (
+	"errors"
+	"math/big"
+	"slices"
+	"testing"
+)
+
+func TestInt128Converter(t *testing.T) {
+	unsigned := Uint128Converter{}
+	signed := Int128Converter{}
+
+	bytes := make([]byte, 16)
+	value := U128{Hi: 0x0102030405060708, Lo: 0x090A0B0C0D0E0F10}
+
+	unsigned.ToBytesBigEndian(&value, bytes, 0)
+	if !slices.Equal(bytes, []byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}) {
+		t.Errorf("Uint128 ToBytesBigEndian: got %x", bytes)
+	}
+
+	var result U128
+	unsigned.FromBytesBigEndian(&result, bytes, 0)
+	if result != value {
+		t.Errorf("Uint128 FromBytesBigEndian: expected %v, got %v", value, result)
+	}
+
+	unsigned.ToBytesLittleEndian(&value, bytes, 0)
+	if !slices.Equal(bytes, []byte{16, 15, 14, 13, 12, 11, 10, 9, 8, 7, 6, 5, 4, 3, 2, 1}) {
+		t.Errorf("Uint128 ToBytesLittleEndian: got %x", bytes)
+	}
+
+	unsigned.FromBytesLittleEndian(&result, bytes, 0)
+	if result != value {
+		t.Errorf("Uint128 FromBytesLittleEndian: expected %v, got %v", value, result)
+	}
+
+	if maximum := (U128{Hi: ^uint64(0), Lo: ^uint64(0)}).String(); maximum != "340282366920938463463374607431768211455" {
+		t.Errorf("Uint128 String: got %s", maximum)
+	}
+
+	negative := I128{Hi: ^uint64(0), Lo: ^uint64(0) - 1}
+
+	signed.ToBytesBigEndian(&negative, bytes, 0)
+	var signedResult I128
+	signed.FromBytesBigEndian(&signedResult, bytes, 0)
+	if signedResult != negative || signedResult.String() != "-2" {
+		t.Errorf("Int128 FromBytesBigEndian: expected -2, got %v", signedResult)
+	}
+
+	if minimum := (I128{Hi: 1 << 63}).String(); minimum != "-170141183460469231731687303715884105728" {
+		t.Errorf("Int128 String: got %s", minimum)
+	}
+}
+
+func TestBigIntConverter(t *testing.T) {
+	converter := BigInt(4)
+
+	values := map[int64][]byte{
+		0:           {0x00, 0x00, 0x00, 0x00},
+		1:           {0x00, 0x00, 0x00, 0x01},
+		-1:          {0xFF, 0xFF, 0xFF, 0xFF},
+		2147483647:  {0x7F, 0xFF, 0xFF, 0xFF},
+		-2147483648: {0x80, 0x00, 0x00, 0x00},
+		-256:        {0xFF, 0xFF, 0xFF, 0x00},
+	}
+
+	for original, expected := range values {
+		value := big.NewInt(original)
+		bytes := make([]byte, 4)
+
+		if err := converter.ToBytesBigEndian(&value, bytes, 0); err != nil {
+			t.Fatalf("BigInt %d: unexpected error %v", original, err)
+		}
+
+		if !slices.Equal(bytes, expected) {
+			t.Errorf("BigInt ToBytesBigEndian %d: expected %x, got %x", original, expected, bytes)
+		}
+
+		var resultBigEndian *big.Int
+		converter.FromBytesBigEndian(&resultBigEndian, bytes, 0)
+		if resultBigEndian.Int64() != original {
+			t.Errorf("BigInt FromBytesBigEndian %d: got %v", original, resultBigEndian)
+		}
+
+		if err := converter.ToBytesLittleEndian(&value, bytes, 0); err != nil {
+			t.Fatalf("BigInt %d: unexpected error %v", original, err)
+		}
+
+		slices.Reverse(bytes)
+		if !slices.Equal(bytes, expected) {
+			t.Errorf("BigInt ToBytesLittleEndian %d: expected reversed %x, got %x", original, expected, bytes)
+		}
+
+		slices.Reverse(bytes)
+		var resultLittleEndian *big.Int
+		converter.FromBytesLittleEndian(&resultLittleEndian, bytes, 0)
+		if resultLittleEndian.Int64() != original {
+			t.Errorf("BigInt FromBytesLittleEndian %d: got %v", original, resultLittleEndian)
+		}
+	}
+
+	for _, overflow := range []int64{2147483648, -2147483649} {
+		value := big.NewInt(overflow)
+		if err := converter.ToBytesBigEndian(&value, make([]byte, 4), 0); !errors.Is(err, ErrOverflow) {
+			t.Errorf("BigInt %d: expected overflow, got %v", overflow, err)
+		}
+	}
+
+	unsigned := BigUint(2)
+	bytes := make([]byte, 2)
+
+	for value, expected := range map[int64]error{65535: nil, 65536: ErrOverflow, -1: ErrOverflow} {
+		value := big.NewInt(value)
+		if err := unsigned.ToBytesLittleEndian(&value, bytes, 0); !errors.Is(err, expected) {
+			t.Errorf("BigUint %v: expected %v, got %v", value, expected, err)
+		}
+	}
+
+	var empty *big.Int
+	bytes = []byte{0xAA, 0xAA}
+	if err := unsigned.ToBytesBigEndian(&empty, bytes, 0); err != nil || !slices.Equal(bytes, []byte{0, 0}) {
+		t.Errorf("BigUint nil: expected zero bytes, got %x and %v", bytes, err)
+	}
+}
+
+func TestInt128Constructors(t *testing.T) {
+	if value := U128From64(42); value != (U128{Lo: 42}) {
+		t.Errorf("U128From64: got %v", value)
+	}
+
+	for original, expected := range map[int64]I128{
+		0:                    {},
+		1:                    {Lo: 1},
+		-1:                   {Hi: ^uint64(0), Lo: ^uint64(0)},
+		-9223372036854775808: {Hi: ^uint64(0), Lo: 1 << 63},
+	} {
+		if value := I128From64(original); value != expected || value.Big().Int64() != original {
+			t.Errorf("I128From64 %d: expected %v, got %v", original, expected, value)
+		}
+	}
+
+	maximum := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 128), big.NewInt(1))
+	signedMaximum := new(big.Int).Rsh(maximum, 1)
+	signedMinimum := new(big.Int).Not(signedMaximum)
+
+	for _, value := range []*big.Int{big.NewInt(0), big.NewInt(1 << 62), new(big.Int).Lsh(big.NewInt(3), 100), maximum} {
+		result, err := U128FromBig(value)
+		if err != nil || result.Big().Cmp(value) != 0 {
+			t.Errorf("U128FromBig %v: got %v and %v", value, result, err)
+		}
+	}
+
+	for _, value := range []*big.Int{big.NewInt(-1), new(big.Int).Add(maximum, big.NewInt(1))} {
+		if _, err := U128FromBig(value); !errors.Is(err, ErrOverflow) {
+			t.Errorf("U128FromBig %v: expected overflow, got %v", value, err)
+		}
+	}
+
+	for _, value := range []*big.Int{big.NewInt(0), big.NewInt(-2), new(big.Int).Lsh(big.NewInt(-5), 90), signedMaximum, signedMinimum} {
+		result, err := I128FromBig(value)
+		if err != nil || result.Big().Cmp(value) != 0 {
+			t.Errorf("I128FromBig %v: got %v and %v", value, result, err)
+		}
+	}
+
+	for _, value := range []*big.Int{new(big.Int).Add(signedMaximum, big.NewInt(1)), new(big.Int).Sub(signedMinimum, big.NewInt(1))} {
+		if _, err := I128FromBig(value); !errors.Is(err, ErrOverflow) {
+			t.Errorf("I128FromBig %v: expected overflow, got %v", value, err)
+		}
+	}
+}
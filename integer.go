@@ -0,0 +1,124 @@
+package packed
+
+import (
+	"fmt"
+
+	"golang.org/x/exp/constraints"
+)
+
+func Int(bytes int) any {
+
+	switch {
+	case bytes == 1:
+		return IntConverter[int8]{Bytes: bytes}
+	case bytes == 2:
+		return IntConverter[int16]{Bytes: bytes}
+	case bytes >= 3 && bytes <= 4:
+		return IntConverter[int32]{Bytes: bytes}
+	case bytes >= 5 && bytes <= 8:
+		return IntConverter[int64]{Bytes: bytes}
+	}
+
+	panic(fmt.Sprintf("integer bytes must be between 1 and 8, got %d", bytes))
+}
+
+func Uint(bytes int) any {
+
+	switch {
+	case bytes == 1:
+		return UintConverter[uint8]{Bytes: bytes}
+	case bytes == 2:
+		return UintConverter[uint16]{Bytes: bytes}
+	case bytes >= 3 && bytes <= 4:
+		return UintConverter[uint32]{Bytes: bytes}
+	case bytes >= 5 && bytes <= 8:
+		return UintConverter[uint64]{Bytes: bytes}
+	}
+
+	panic(fmt.Sprintf("integer bytes must be between 1 and 8, got %d", bytes))
+}
+
+type IntConverter[Integer constraints.Signed] struct {
+	Bytes int `packed_hash_field:"bytes"`
+}
+
+func (c *IntConverter[Integer]) InitializeConverterFields() map[string]string {
+	return map[string]string{"Bytes": fmt.Sprintf("%d", c.Bytes)}
+}
+
+func (c *IntConverter[Integer]) Size() int { return c.Bytes }
+
+func (c *IntConverter[Integer]) extend(value uint64) Integer {
+	shift := 64 - 8*c.Bytes
+	return Integer(int64(value<<shift) >> shift)
+}
+
+func (c *IntConverter[Integer]) ToBytesLittleEndian(value *Integer, bytes []byte, index int) {
+	v := uint64(*value)
+	for i := 0; i < c.Bytes; i++ {
+		bytes[index+i] = byte(v >> (8 * i))
+	}
+}
+
+func (c *IntConverter[Integer]) FromBytesLittleEndian(receiver *Integer, bytes []byte, index int) {
+	var v uint64
+	for i := 0; i < c.Bytes; i++ {
+		v |= uint64(bytes[index+i]) << (8 * i)
+	}
+	*receiver = c.extend(v)
+}
+
+func (c *IntConverter[Integer]) ToBytesBigEndian(value *Integer, bytes []byte, index int) {
+	v := uint64(*value)
+	for i := 0; i < c.Bytes; i++ {
+		bytes[index+c.Bytes-1-i] = byte(v >> (8 * i))
+	}
+}
+
+func (c *IntConverter[Integer]) FromBytesBigEndian(receiver *Integer, bytes []byte, index int) {
+	var v uint64
+	for i := 0; i < c.Bytes; i++ {
+		v |= uint64(bytes[index+c.Bytes-1-i]) << (8 * i)
+	}
+	*receiver = c.extend(v)
+}
+
+type UintConverter[Integer constraints.Unsigned] struct {
+	Bytes int `packed_hash_field:"bytes"`
+}
+
+func (c *UintConverter[Integer]) InitializeConverterFields() map[string]string {
+	return map[string]string{"Bytes": fmt.Sprintf("%d", c.Bytes)}
+}
+
+func (c *UintConverter[Integer]) Size() int { return c.Bytes }
+
+func (c *UintConverter[Integer]) ToBytesLittleEndian(value *Integer, bytes []byte, index int) {
+	v := uint64(*value)
+	for i := 0; i < c.Bytes; i++ {
+		bytes[index+i] = byte(v >> (8 * i))
+	}
+}
+
+func (c *UintConverter[Integer]) FromBytesLittleEndian(receiver *Integer, bytes []byte, index int) {
+	var v uint64
+	for i := 0; i < c.Bytes; i++ {
+		v |= uint64(bytes[index+i]) << (8 * i)
+	}
+	*receiver = Integer(v)
+}
+
+func (c *UintConverter[Integer]) ToBytesBigEndian(value *Integer, bytes []byte, index int) {
+	v := uint64(*value)
+	for i := 0; i < c.Bytes; i++ {
+		bytes[index+c.Bytes-1-i] = byte(v >> (8 * i))
+	}
+}
+
+func (c *UintConverter[Integer]) FromBytesBigEndian(receiver *Integer, bytes []byte, index int) {
+	var v uint64
+	for i := 0; i < c.Bytes; i++ {
+		v |= uint64(bytes[index+c.Bytes-1-i]) << (8 * i)
+	}
+	*receiver = Integer(v)
+}
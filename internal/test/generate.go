@@ -5,6 +5,7 @@ package main
 import (
 	"os"
 	"path"
+	"time"
 
 	. "github.com/0-Mqix/packed"
 	types "github.com/0-Mqix/packed/internal/test/types"
@@ -90,6 +91,345 @@ func main() {
 		Field("B", Array(2, K)),
 	)
 
+	N := Struct("N", true,
+		Field("Count", Uint16),
+		Field("Length", Bits[uint8](4)),
+		Field("Flag", Bits[uint8](4)),
+		Field("Values", Slice("Count", Int32)),
+		Field("Name", VarString("Length")),
+		Field("Trailer", Uint8),
+	)
+
+	Struct("O", false,
+		Field("A", N),
+		Field("DataLength", Uint8),
+		Field("Data", Bytes("DataLength")),
+		Field("Count", Cast[types.ExampleEnum](Int8)),
+		Field("Items", Slice("Count", H)),
+	)
+
+	PFlags := Struct("PFlags", false,
+		Field("HasTimestamp", Bit),
+		Field("HasKind", Bit),
+		Field("HasValues", Bit),
+		Field("Reserved", Bits[uint8](5)),
+	)
+
+	Struct("P", false,
+		Field("Flags", PFlags),
+		Field("HasInner", Boolean),
+		Field("Timestamp", Uint32, When("Flags.HasTimestamp")),
+		Field("Kind", Cast[types.ExampleEnum](Int8), When("Flags.HasKind")),
+		Field("Values", Array(2, Int16), When("Flags.HasValues")),
+		Field("Inner", N, When("HasInner")),
+		Field("Trailer", Uint8),
+	)
+
+	QA := Struct("QA", true,
+		Field("A", Uint16),
+		Field("B", Int8),
+	)
+
+	QB := Struct("QB", true,
+		Field("Length", Uint8),
+		Field("Text", VarString("Length")),
+	)
+
+	QC := Struct("QC", true,
+		Field("A", Uint32),
+	)
+
+	Struct("Q", true,
+		Field("Type", Cast[types.ExampleEnum](Uint8)),
+		Field("Kind", Bits[uint8](4)),
+		Field("Reserved", Bits[uint8](4)),
+		Field("Payload", Union("Type",
+			Variant(types.ExampleEnumValueA, QA),
+			Variant(types.ExampleEnumValueB, QB),
+		)),
+		Field("Fixed", FixedUnion("Kind",
+			Variant(1, QA),
+			Variant(3, QC),
+		)),
+		Field("Trailer", Uint8),
+	)
+
+	RA := Struct("RA", true,
+		Field("A", Uint8),
+		Padding(1, Fill(0xAA), Verify()),
+	)
+
+	Struct("R", false,
+		Field("A", Uint8),
+		Padding(3),
+		Field("B", Uint16),
+		Padding(2, Fill(0xFF), Verify()),
+		Field("C", Bits[uint8](3)),
+		ReservedBits(5, Fill(0x15), Verify()),
+		Field("D", Bit),
+		ReservedBits(7),
+		Field("E", Array(2, RA)),
+	)
+
+	SA := AlignedStruct("SA", true, Natural,
+		Field("A", Uint8),
+		Field("B", Uint16),
+	)
+
+	AlignedStruct("S", true, Natural,
+		Field("A", Uint8),
+		Field("B", Int32),
+		Field("C", Bits[uint16](3)),
+		Field("D", Float64),
+		Field("E", Array(3, SA)),
+		Field("F", Uint8, Align(4)),
+	)
+
+	AlignedStruct("T", false, Pack(2),
+		Field("A", Uint8),
+		Field("B", Int64),
+		Field("C", SA),
+		Field("D", Uint8),
+	)
+
+	UA := Struct("UA", true,
+		Field("Marker", Const(Uint16, 0xBEEF)),
+		Field("A", Uint8),
+	)
+
+	Struct("U", false,
+		Field("Magic", Const(Uint32, 0xCAFEBABE)),
+		Field("Tag", Const(String(4), "RIFF")),
+		Field("Raw", Const(Array(2, Uint8), [2]byte{0xDE, 0xAD})),
+		Field("Kind", Const(Cast[types.ExampleEnum](Int16), types.ExampleEnumValueC)),
+		Field("A", Uint16),
+		Field("B", Array(2, UA)),
+	)
+
+	VA := Struct("VA", false,
+		Field("Length", Uint16),
+		Field("Kind", Uint8),
+		Field("CRC", Checksum(CRC8, "", "")),
+	)
+
+	Struct("V", false,
+		Field("Header", VA),
+		Field("Payload", Bytes("Header.Length")),
+		Field("Parity", Checksum(Xor8, "Payload", "Payload")),
+		Field("Sum", Checksum(Sum16, "Header", "Payload"), LittleEndian(true)),
+		Field("CRC", Checksum(CRC32, "", "")),
+	)
+
+	WA := Struct("WA", true,
+		Field("Kind", Uint8),
+		Field("Entries", Bits[uint8](4), Computed(CountOf("Values")), Verify()),
+		Field("Flags", Bits[uint8](4)),
+		Field("Values", Array(4, Uint16)),
+	)
+
+	Struct("W", false,
+		Field("Length", Uint16, Computed(SizeOf("")), Verify()),
+		Field("PayloadOffset", Uint8, Computed(OffsetOf("Payload"))),
+		Field("PayloadSize", Uint16, Computed(SizeOf("Payload"))),
+		Field("Count", Uint8),
+		Field("Header", WA),
+		Field("Payload", Bytes("Count")),
+		Field("Trailer", Uint8),
+		Field("TrailerOffset", Uint16, Computed(OffsetOf("Trailer")), Verify()),
+	)
+
+	XA := Struct("XA", true,
+		Field("A", Uint8, Range(1, 10)),
+		Field("B", Bits[uint8](3), OneOf(1, 2, 4)),
+		Field("C", Bits[uint8](5)),
+	)
+
+	Struct("X", false,
+		Field("Kind", Cast[types.ExampleEnum](Int8), ValidEnum()),
+		Field("Name", String(4), NotZero()),
+		Field("Level", Float32, Range(-1.5, 1.5)),
+		Field("Mode", Cast[types.ExampleEnumString](String(1)), OneOf(types.ExampleEnumStringValueA, types.ExampleEnumStringValueB)),
+		Field("Matrix", Array(2, Array(2, Uint16)), Range(0, 1000)),
+		Field("B", Array(3, XA)),
+		Field("Nested", XA),
+	)
+
+	Color := Enum("Color", Uint8,
+		EnumValue("Red", 1),
+		EnumValue("Green", 2),
+		EnumValue("Blue", 4),
+	)
+
+	Direction := Enum("Direction", Int16,
+		EnumValue("Left", -1),
+		EnumValue("None", 0),
+		EnumValue("Right", 1),
+	)
+
+	Struct("Y", false,
+		Field("Color", Color),
+		Field("Strict", Color.Strict()),
+		Field("Palette", Array(3, Color)),
+		Field("Direction", Direction, ValidEnum()),
+		Field("Low", Bits[uint8](3, Color)),
+		Field("High", Bits[uint8](5, Color.Strict())),
+		Field("Default", Const(Color, 2)),
+	)
+
+	Struct("Z", true,
+		Field("Status", Flags("Status", 6, "Ready", "Error", "", "Busy")),
+		Field("Mode", Bits[uint8](2)),
+		Field("Features", Flags("Features", 12, "Wide", "Fast")),
+		ReservedBits(4),
+	)
+
+	Struct("AA", false,
+		Field("Level", Q15),
+		Field("Position", FixedPoint(32, 16, true), LittleEndian(true)),
+		Field("Temperature", Decimal(16, 2, true)),
+		Field("Offset", Bits[uint64](12, FixedPoint(12, 4, true))),
+		Field("Gain", Bits[uint64](4, FixedPoint(4, 2, false))),
+	)
+
+	Struct("AB", false,
+		Field("Half", Float16),
+		Field("Brain", BFloat16, LittleEndian(true)),
+		Field("Single", IBMFloat32),
+		Field("Double", IBMFloat64),
+		Field("Weights", Array(2, Float16)),
+	)
+
+	Struct("AC", false,
+		Field("Sample", Int(3)),
+		Field("Timestamp", Uint(6), LittleEndian(true)),
+		Field("Samples", Array(2, Int(3))),
+		Field("Wide", Cast[int](Int(5))),
+		Field("Count", Uint(3), Computed(CountOf("Samples"))),
+	)
+
+	Struct("AD", false,
+		Field("Hash", Uint128),
+		Field("Delta", Int128, LittleEndian(true)),
+		Field("Amount", BigInt(32)),
+		Field("Supply", BigUint(8), LittleEndian(true)),
+		Field("Balances", Array(2, BigInt(3))),
+	)
+
+	Struct("AE", false,
+		Field("Type", Uint8),
+		Field("Remaining", MQTTVarint),
+		Field("ID", Varint),
+		Field("Length", Varint),
+		Field("Payload", Bytes("Length")),
+		Field("Delta", ZigzagVarint),
+		Field("Offset", SignedLEB128),
+		Field("HasExtra", Boolean),
+		Field("Extra", Varint, When("HasExtra")),
+		Field("Trailer", Uint16),
+		Field("Total", Uint8, Computed(SizeOf("")), Verify()),
+	)
+
+	Struct("AF", false,
+		Field("Reading", BCD(6).Strict()),
+		Field("Offset", SignMagnitude(16), LittleEndian(true)),
+		Field("Legacy", OnesComplement(8)),
+		Field("Position", Gray(12)),
+		Field("Seconds", Bits[uint64](8, BCD(2).Strict())),
+		Field("Encoder", Bits[uint64](4, Gray(4))),
+		Field("Trim", Bits[uint64](4, SignMagnitude(4))),
+	)
+
+	Struct("AG", false,
+		Field("Created", UnixSeconds32),
+		Field("Modified", UnixMillis, LittleEndian(true)),
+		Field("Written", FileTime, LittleEndian(true)),
+		Field("Synchronized", NTPTime),
+		Field("Fix", GPSTime),
+		Field("Archived", DOSDateTime, LittleEndian(true)),
+		Field("Timeout", Duration(2, false, 10*time.Millisecond)),
+	)
+
+	Struct("AH", false,
+		Field("Identifier", RFC4122UUID),
+		Field("Class", MicrosoftGUID),
+		Field("Members", Array(2, MicrosoftGUID)),
+	)
+
+	Struct("AI", false,
+		Field("Source", MACAddress),
+		Field("Destination", HardwareAddress),
+		Field("Gateway", IPv4),
+		Field("Peer", IPv4AddrPort),
+		Field("Link", IPv6.Strict()),
+	)
+
+	Struct("AJ", false,
+		Field("Vendor", String(8).Padded(' ')),
+		Field("Label", String(6).Terminated().NoTruncate()),
+		Field("Owner", String(4).Encoded(StringEncodingLatin1)),
+		Field("Title", String(4).Encoded(StringEncodingUTF16LE).ValidUTF8()),
+	)
+
+	Struct("AK", false,
+		Field("Key", FixedBytes(16)),
+		Field("Hashes", Array(2, FixedBytes(4))),
+		Field("Offsets", Array(3, Int8)),
+		Field("Count", Uint8),
+		Field("Blob", Slice("Count", Uint8)),
+	)
+
+	Struct("AL", false,
+		Field("Name", FixedString("RecordName", String(8).Padded(' '))),
+		Field("Tags", Array(2, FixedString("RecordTag", String(4).Terminated()))),
+		Field("Comment", String(8).Unsafe()),
+		Field("Sequence", Uint32),
+	)
+
+	Struct("AM", false,
+		Field("Temperature", Scaled(Int16, 0.1, -40)),
+		Field("Pressure", Scaled(Uint(3), 0.01, 0), LittleEndian(true)),
+		Field("Voltage", Scaled(Bits[uint16](12), 0.005, 0)),
+		Field("Current", Scaled(Bits[int16](12), 0.25, 0)),
+		Field("Valid", Bit),
+		Field("Mode", Bits[uint8](7)),
+	)
+
+	Struct("AN", false,
+		Field("Background", RGB565, LittleEndian(true)),
+		Field("Palette", Array(2, RGBA8888)),
+		Field("Tiles", Array(2, ABGR8888), LittleEndian(true)),
+		Field("Icon", Array(2, ARGB1555)),
+		Field("Left", Bits[uint64](4, Grayscale4)),
+		Field("Right", Bits[uint64](4, Grayscale4)),
+		Field("Overlay", Bits[uint64](16, RGBA4444)),
+		Field("Count", Uint8),
+		Field("Pixels", Slice("Count", RGBA8888)),
+	)
+
+	Struct("AO", false,
+		Field("Count", Uint64),
+		Field("Items", Slice("Count", Uint16)),
+		Field("Trailer", Uint8),
+	)
+
+	Struct("AP", false,
+		Field("Kind", Color),
+		Field("Heading", Bits[int8](2, Direction)),
+		Field("Count", Bits[uint8](6, Color)),
+		Field("Payload", Union("Kind",
+			Variant(1, QA),
+			Variant(4, QC),
+		)),
+		Field("Items", Slice("Count", Uint8)),
+	)
+
+	Struct("AQ", true,
+		Field("Kind", Uint8),
+		Field("Payload", Union("Kind",
+			Variant(1, XA),
+		)),
+	)
+
 	workingDirectory, _ := os.Getwd()
 
 	generated := path.Join(workingDirectory, "/output.go")
@@ -1,9 +1,21 @@
 package packed
 
 import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"image/color"
+	"math"
+	"math/big"
+	"net"
+	"net/netip"
 	"reflect"
+	"slices"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/0-Mqix/packed"
 	types "github.com/0-Mqix/packed/internal/test/types"
 )
 
@@ -265,3 +277,1272 @@ func TestBitsConverter(t *testing.T) {
 		t.Errorf("j: expected %v, got %v", definition, result)
 	}
 }
+
+func TestSlices(t *testing.T) {
+
+	definition := O{
+		A: N{
+			Values:  []int32{1, -2, 3},
+			Name:    "name",
+			Flag:    5,
+			Trailer: 9,
+		},
+		Data:  []byte{1, 2, 3, 4},
+		Items: []H{{A: types.ExampleEnumValueA}, {A: types.ExampleEnumValueC}},
+	}
+
+	bytes := make([]byte, definition.Size())
+
+	written, err := definition.ToBytes(bytes, 0)
+
+	if err != nil {
+		t.Fatalf("o: unexpected error %v", err)
+	}
+
+	if written != len(bytes) {
+		t.Errorf("o: expected %d bytes written, got %d", len(bytes), written)
+	}
+
+	if definition.A.Count != 3 || definition.A.Length != 4 || definition.DataLength != 4 || definition.Count != 2 {
+		t.Errorf("o: length fields not synced: %+v", definition)
+	}
+
+	var result O
+
+	read, err := result.FromBytes(bytes, 0)
+
+	if err != nil {
+		t.Fatalf("o: unexpected error %v", err)
+	}
+
+	if read != len(bytes) {
+		t.Errorf("o: expected %d bytes read, got %d", len(bytes), read)
+	}
+
+	if !reflect.DeepEqual(definition, result) {
+		t.Errorf("o: expected %v, got %v", definition, result)
+	}
+
+	if _, err := result.FromBytes(bytes[:len(bytes)-1], 0); !errors.Is(err, packed.ErrShortBuffer) {
+		t.Errorf("o: expected short buffer error, got %v", err)
+	}
+}
+
+func TestSliceLengthOverflow(t *testing.T) {
+
+	definition := N{Name: "this name is too long"}
+
+	bytes := make([]byte, definition.Size())
+
+	_, err := definition.ToBytes(bytes, 0)
+
+	var fieldError *packed.FieldError
+
+	if !errors.As(err, &fieldError) || fieldError.Path != "N.Name" || !errors.Is(err, packed.ErrInvalidLength) {
+		t.Errorf("n: expected invalid length error for N.Name, got %v", err)
+	}
+}
+
+func TestOptionalFields(t *testing.T) {
+
+	timestamp := uint32(123456)
+	kind := types.ExampleEnumValueB
+
+	definitions := []P{
+		{Trailer: 1},
+		{Timestamp: &timestamp, Kind: &kind, Trailer: 2},
+		{Values: &[2]int16{-1, 1}, Inner: &N{Values: []int32{7}, Name: "in"}, Trailer: 3},
+	}
+
+	for _, definition := range definitions {
+
+		bytes := make([]byte, definition.Size())
+
+		if _, err := definition.ToBytes(bytes, 0); err != nil {
+			t.Fatalf("p: unexpected error %v", err)
+		}
+
+		if definition.Flags.HasTimestamp != (definition.Timestamp != nil) || definition.HasInner != (definition.Inner != nil) {
+			t.Errorf("p: presence flags not synced: %+v", definition)
+		}
+
+		result := P{Timestamp: &timestamp}
+
+		read, err := result.FromBytes(bytes, 0)
+
+		if err != nil {
+			t.Fatalf("p: unexpected error %v", err)
+		}
+
+		if read != len(bytes) {
+			t.Errorf("p: expected %d bytes read, got %d", len(bytes), read)
+		}
+
+		if !reflect.DeepEqual(definition, result) {
+			t.Errorf("p: expected %+v, got %+v", definition, result)
+		}
+	}
+}
+
+func TestUnions(t *testing.T) {
+
+	definitions := []Q{
+		{Payload: &QA{A: 513, B: -3}, Fixed: &QC{A: 123456}, Trailer: 1},
+		{Payload: &QB{Text: "variant"}, Fixed: &QA{A: 7, B: 8}, Trailer: 2},
+	}
+
+	for _, definition := range definitions {
+
+		bytes := make([]byte, definition.Size())
+
+		written, err := definition.ToBytes(bytes, 0)
+
+		if err != nil {
+			t.Fatalf("q: unexpected error %v", err)
+		}
+
+		if written != len(bytes) {
+			t.Errorf("q: expected %d bytes written, got %d", len(bytes), written)
+		}
+
+		var result Q
+
+		read, err := result.FromBytes(bytes, 0)
+
+		if err != nil {
+			t.Fatalf("q: unexpected error %v", err)
+		}
+
+		if read != len(bytes) {
+			t.Errorf("q: expected %d bytes read, got %d", len(bytes), read)
+		}
+
+		if !reflect.DeepEqual(definition, result) {
+			t.Errorf("q: expected %+v, got %+v", definition, result)
+		}
+	}
+}
+
+func TestUnionUnknownVariant(t *testing.T) {
+
+	definition := Q{Payload: &QA{}, Fixed: &QC{}}
+
+	bytes := make([]byte, definition.Size())
+
+	if _, err := definition.ToBytes(bytes, 0); err != nil {
+		t.Fatalf("q: unexpected error %v", err)
+	}
+
+	bytes[0] = 3
+
+	var result Q
+
+	if _, err := result.FromBytes(bytes, 0); !errors.Is(err, packed.ErrUnknownVariant) {
+		t.Errorf("q: expected unknown variant error, got %v", err)
+	}
+
+	if _, err := (&Q{Fixed: &QC{}}).ToBytes(bytes, 0); !errors.Is(err, packed.ErrUnknownVariant) {
+		t.Errorf("q: expected unknown variant error for nil payload, got %v", err)
+	}
+}
+
+func TestPadding(t *testing.T) {
+
+	definition := R{A: 1, B: 2, C: 3, D: true, E: [2]RA{{A: 4}, {A: 5}}}
+
+	bytes := make([]byte, definition.Size())
+
+	if _, err := definition.ToBytes(bytes, 0); err != nil {
+		t.Fatalf("r: unexpected error %v", err)
+	}
+
+	expected := []byte{1, 0, 0, 0, 0, 2, 0xFF, 0xFF, 0x75, 0x80, 4, 0xAA, 5, 0xAA}
+
+	if !reflect.DeepEqual(bytes, expected) {
+		t.Errorf("r: expected bytes %v, got %v", expected, bytes)
+	}
+
+	if reflect.TypeOf(definition).NumField() != 5 {
+		t.Errorf("r: expected padding to be excluded from the struct definition")
+	}
+
+	var result R
+
+	if _, err := result.FromBytes(bytes, 0); err != nil {
+		t.Fatalf("r: unexpected error %v", err)
+	}
+
+	if !reflect.DeepEqual(definition, result) {
+		t.Errorf("r: expected %+v, got %+v", definition, result)
+	}
+
+	for _, position := range []int{7, 8, 13} {
+
+		corrupted := append([]byte{}, bytes...)
+		corrupted[position] ^= 0x08
+
+		if _, err := result.FromBytes(corrupted, 0); !errors.Is(err, packed.ErrInvalidPadding) {
+			t.Errorf("r: expected invalid padding error for byte %d, got %v", position, err)
+		}
+	}
+}
+
+func TestAlignment(t *testing.T) {
+
+	sizes := map[string]int{
+		"SA": (&SA{}).Size(),
+		"S":  (&S{}).Size(),
+		"T":  (&T{}).Size(),
+	}
+
+	expected := map[string]int{"SA": 4, "S": 40, "T": 16}
+
+	if !reflect.DeepEqual(sizes, expected) {
+		t.Errorf("expected sizes %v, got %v", expected, sizes)
+	}
+
+	definition := S{A: 1, B: 2, C: 3, D: 4, E: [3]SA{{A: 5, B: 6}, {A: 7, B: 8}, {A: 9, B: 10}}, F: 11}
+
+	bytes := make([]byte, definition.Size())
+
+	if _, err := definition.ToBytes(bytes, 0); err != nil {
+		t.Fatalf("s: unexpected error %v", err)
+	}
+
+	for offset, value := range map[int]byte{0: 1, 4: 2, 8: 3, 24: 5, 26: 6, 28: 7, 36: 11} {
+		if bytes[offset] != value {
+			t.Errorf("s: expected %d at offset %d, got %d", value, offset, bytes[offset])
+		}
+	}
+
+	var result S
+
+	if _, err := result.FromBytes(bytes, 0); err != nil {
+		t.Fatalf("s: unexpected error %v", err)
+	}
+
+	if !reflect.DeepEqual(definition, result) {
+		t.Errorf("s: expected %+v, got %+v", definition, result)
+	}
+}
+
+func TestConst(t *testing.T) {
+
+	definition := U{A: 7, B: [2]UA{{A: 1}, {A: 2}}}
+
+	bytes := make([]byte, definition.Size())
+
+	if _, err := definition.ToBytes(bytes, 0); err != nil {
+		t.Fatalf("u: unexpected error %v", err)
+	}
+
+	expected := []byte{0xCA, 0xFE, 0xBA, 0xBE, 'R', 'I', 'F', 'F', 0xDE, 0xAD, 0, 3, 0, 7, 0xEF, 0xBE, 1, 0xEF, 0xBE, 2}
+
+	if !reflect.DeepEqual(bytes, expected) {
+		t.Errorf("u: expected bytes %x, got %x", expected, bytes)
+	}
+
+	if definition.Magic() != 0xCAFEBABE || definition.Tag() != "RIFF" || definition.Kind() != types.ExampleEnumValueC {
+		t.Errorf("u: unexpected constant getters")
+	}
+
+	var result U
+
+	if _, err := result.FromBytes(bytes, 0); err != nil {
+		t.Fatalf("u: unexpected error %v", err)
+	}
+
+	if !reflect.DeepEqual(definition, result) {
+		t.Errorf("u: expected %+v, got %+v", definition, result)
+	}
+
+	for position, path := range map[int]string{0: "U.Magic", 6: "U.Tag", 9: "U.Raw", 11: "U.Kind", 18: "U.B.Marker"} {
+
+		corrupted := append([]byte{}, bytes...)
+		corrupted[position]++
+
+		_, err := result.FromBytes(corrupted, 0)
+
+		var fieldError *packed.FieldError
+
+		if !errors.Is(err, packed.ErrConstMismatch) || !errors.As(err, &fieldError) || fieldError.Path != path {
+			t.Errorf("u: expected constant mismatch for %s, got %v", path, err)
+		}
+	}
+}
+
+func TestChecksum(t *testing.T) {
+
+	definition := V{Header: VA{Kind: 1}, Payload: []byte("123456789")}
+
+	bytes := make([]byte, definition.Size())
+
+	if _, err := definition.ToBytes(bytes, 0); err != nil {
+		t.Fatalf("v: unexpected error %v", err)
+	}
+
+	if uint64(bytes[3]) != packed.CRC8.Checksum(bytes[0:3]) || definition.Header.CRC != bytes[3] {
+		t.Errorf("v: unexpected header checksum %x", bytes[3])
+	}
+
+	if uint64(bytes[13]) != packed.Xor8.Checksum(bytes[4:13]) || definition.Parity != bytes[13] {
+		t.Errorf("v: unexpected parity %x", bytes[13])
+	}
+
+	if sum := uint64(bytes[14]) | uint64(bytes[15])<<8; sum != packed.Sum16.Checksum(bytes[0:13]) || uint64(definition.Sum) != sum {
+		t.Errorf("v: unexpected sum %x", sum)
+	}
+
+	if crc := uint64(bytes[16])<<24 | uint64(bytes[17])<<16 | uint64(bytes[18])<<8 | uint64(bytes[19]); crc != packed.CRC32.Checksum(bytes[0:16]) || uint64(definition.CRC) != crc {
+		t.Errorf("v: unexpected crc %x", crc)
+	}
+
+	var result V
+
+	if _, err := result.FromBytes(bytes, 0); err != nil {
+		t.Fatalf("v: unexpected error %v", err)
+	}
+
+	if !reflect.DeepEqual(definition, result) {
+		t.Errorf("v: expected %+v, got %+v", definition, result)
+	}
+
+	for position, path := range map[int]string{2: "V.Header.CRC", 3: "V.Header.CRC", 8: "V.Parity", 15: "V.Sum", 19: "V.CRC"} {
+
+		corrupted := append([]byte{}, bytes...)
+		corrupted[position]++
+
+		_, err := result.FromBytes(corrupted, 0)
+
+		var fieldError *packed.FieldError
+
+		if !errors.Is(err, packed.ErrChecksumMismatch) || !errors.As(err, &fieldError) || fieldError.Path != path {
+			t.Errorf("v: expected checksum mismatch for %s, got %v", path, err)
+		}
+	}
+}
+
+func TestComputedFields(t *testing.T) {
+
+	definition := W{Header: WA{Values: [4]uint16{1, 0, 3, 0}}, Payload: []byte{9, 8, 7}, Trailer: 5}
+
+	bytes := make([]byte, definition.Size())
+
+	if _, err := definition.ToBytes(bytes, 0); err != nil {
+		t.Fatalf("w: unexpected error %v", err)
+	}
+
+	if definition.Length != 22 || definition.PayloadOffset != 16 || definition.PayloadSize != 3 || definition.TrailerOffset != 19 || definition.Header.Entries != 2 {
+		t.Errorf("w: unexpected computed values %+v", definition)
+	}
+
+	var result W
+
+	if _, err := result.FromBytes(bytes, 0); err != nil {
+		t.Fatalf("w: unexpected error %v", err)
+	}
+
+	if !reflect.DeepEqual(definition, result) {
+		t.Errorf("w: expected %+v, got %+v", definition, result)
+	}
+
+	for position, path := range map[int]string{1: "W.Length", 7: "W.Header.Entries", 21: "W.TrailerOffset"} {
+
+		corrupted := append([]byte{}, bytes...)
+		corrupted[position] += 0x10
+
+		_, err := result.FromBytes(corrupted, 0)
+
+		var fieldError *packed.FieldError
+
+		if !errors.Is(err, packed.ErrComputedMismatch) || !errors.As(err, &fieldError) || fieldError.Path != path {
+			t.Errorf("w: expected computed mismatch for %s, got %v", path, err)
+		}
+	}
+
+	corrupted := append([]byte{}, bytes...)
+	corrupted[2]++
+
+	if _, err := result.FromBytes(corrupted, 0); err != nil {
+		t.Errorf("w: unverified computed fields should not be checked, got %v", err)
+	}
+}
+
+func TestValidate(t *testing.T) {
+
+	valid := func() X {
+		element := XA{A: 5, B: 2}
+		return X{Kind: types.ExampleEnumValueB, Name: "ab", Level: 0.5, Mode: types.ExampleEnumStringValueA, B: [3]XA{element, element, element}, Nested: element}
+	}
+
+	definition := valid()
+
+	if err := definition.Validate(); err != nil {
+		t.Fatalf("x: unexpected error %v", err)
+	}
+
+	tests := map[string]func(x *X){
+		"X.Kind":         func(x *X) { x.Kind = 7 },
+		"X.Name":         func(x *X) { x.Name = "" },
+		"X.Level":        func(x *X) { x.Level = -2 },
+		"X.Mode":         func(x *X) { x.Mode = types.ExampleEnumStringValueC },
+		"X.Matrix[1][0]": func(x *X) { x.Matrix[1][0] = 1001 },
+		"X.B[1].A":       func(x *X) { x.B[1].A = 11 },
+		"X.B[2].B":       func(x *X) { x.B[2].B = 3 },
+		"X.Nested.A":     func(x *X) { x.Nested.A = 0 },
+	}
+
+	for path, modify := range tests {
+
+		definition := valid()
+		modify(&definition)
+
+		err := definition.Validate()
+
+		var fieldError *packed.FieldError
+
+		if !errors.Is(err, packed.ErrInvalidValue) || !errors.As(err, &fieldError) || fieldError.Path != path {
+			t.Errorf("x: expected invalid value for %s, got %v", path, err)
+		}
+	}
+
+	if _, ok := reflect.TypeFor[*Q]().MethodByName("Validate"); ok {
+		t.Errorf("q: expected no Validate method without constraints")
+	}
+
+	if _, ok := reflect.TypeFor[*AQ]().MethodByName("Validate"); !ok {
+		t.Errorf("aq: expected a Validate method for a union with constrained variants")
+	}
+
+	variant := AQ{Payload: &XA{A: 11, B: 1}}
+	err := variant.Validate()
+
+	var fieldError *packed.FieldError
+
+	if !errors.Is(err, packed.ErrInvalidValue) || !errors.As(err, &fieldError) || fieldError.Path != "AQ.Payload.A" {
+		t.Errorf("aq: expected invalid value for AQ.Payload.A, got %v", err)
+	} else if errors.As(fieldError.Err, new(*packed.FieldError)) {
+		t.Errorf("aq: expected a single field error, got %v", err)
+	}
+}
+
+func TestEnums(t *testing.T) {
+
+	if ColorBlue.String() != "Blue" || Color(3).String() != "Color(3)" || !DirectionLeft.IsValid() || Color(3).IsValid() {
+		t.Errorf("color: unexpected enum methods")
+	}
+
+	if value, err := ParseDirection("Right"); err != nil || value != DirectionRight {
+		t.Errorf("direction: expected Right, got %v %v", value, err)
+	}
+
+	if _, err := ParseColor("Purple"); !errors.Is(err, packed.ErrInvalidValue) {
+		t.Errorf("color: expected invalid value, got %v", err)
+	}
+
+	definition := Y{Color: 9, Strict: ColorGreen, Palette: [3]Color{ColorRed, 6, ColorBlue}, Direction: DirectionLeft, Low: ColorBlue, High: ColorRed}
+
+	bytes := make([]byte, definition.Size())
+
+	if _, err := definition.ToBytes(bytes, 0); err != nil {
+		t.Fatalf("y: unexpected error %v", err)
+	}
+
+	expected := []byte{9, 2, 1, 6, 4, 0xFF, 0xFF, 4<<5 | 1, 2}
+
+	if !reflect.DeepEqual(bytes, expected) {
+		t.Errorf("y: expected bytes %x, got %x", expected, bytes)
+	}
+
+	var result Y
+
+	if _, err := result.FromBytes(bytes, 0); err != nil {
+		t.Fatalf("y: unexpected error %v", err)
+	}
+
+	if !reflect.DeepEqual(definition, result) {
+		t.Errorf("y: expected %+v, got %+v", definition, result)
+	}
+
+	if result.Default() != ColorGreen {
+		t.Errorf("y: expected default Green, got %v", result.Default())
+	}
+
+	for position, path := range map[int]string{1: "Y.Strict", 7: "Y.High"} {
+
+		corrupted := append([]byte{}, bytes...)
+		corrupted[position] = 3
+
+		_, err := result.FromBytes(corrupted, 0)
+
+		var fieldError *packed.FieldError
+
+		if !errors.Is(err, packed.ErrInvalidValue) || !errors.As(err, &fieldError) || fieldError.Path != path {
+			t.Errorf("y: expected unknown value for %s, got %v", path, err)
+		}
+	}
+
+	definition.Direction = 5
+
+	if err := definition.Validate(); !errors.Is(err, packed.ErrInvalidValue) {
+		t.Errorf("y: expected invalid direction, got %v", err)
+	}
+}
+
+func TestFlags(t *testing.T) {
+
+	var status Status
+
+	status.Set(StatusReady)
+	status.Set(StatusBusy)
+
+	if !status.Has(StatusReady) || status.Has(StatusError) || status.String() != "Ready|Busy" {
+		t.Errorf("status: unexpected flags %v", status)
+	}
+
+	status.Clear(StatusReady)
+	status |= 1 << 5
+
+	if status.String() != "Busy|0x20" || Status(0).String() != "0" {
+		t.Errorf("status: unexpected string %v", status)
+	}
+
+	definition := Z{Status: status | StatusError, Mode: 2, Features: FeaturesFast | 1<<11}
+
+	bytes := make([]byte, definition.Size())
+
+	if _, err := definition.ToBytes(bytes, 0); err != nil {
+		t.Fatalf("z: unexpected error %v", err)
+	}
+
+	expected := []byte{0x2A | 2<<6, 0x02, 0x08}
+
+	if !reflect.DeepEqual(bytes, expected) {
+		t.Errorf("z: expected bytes %x, got %x", expected, bytes)
+	}
+
+	var result Z
+
+	if _, err := result.FromBytes(bytes, 0); err != nil {
+		t.Fatalf("z: unexpected error %v", err)
+	}
+
+	if !reflect.DeepEqual(definition, result) {
+		t.Errorf("z: expected %+v, got %+v", definition, result)
+	}
+}
+
+func TestFixedPoint(t *testing.T) {
+
+	definition := AA{Level: -0.25, Position: 1.5, Temperature: 21.5, Offset: -2.5, Gain: 5}
+
+	bytes := make([]byte, definition.Size())
+
+	if _, err := definition.ToBytes(bytes, 0); err != nil {
+		t.Fatalf("aa: unexpected error %v", err)
+	}
+
+	expected := []byte{0xE0, 0x00, 0x00, 0x80, 0x01, 0x00, 0x08, 0x66, 0xFD, 0x8F}
+
+	if !reflect.DeepEqual(bytes, expected) {
+		t.Errorf("aa: expected bytes %x, got %x", expected, bytes)
+	}
+
+	var result AA
+
+	if _, err := result.FromBytes(bytes, 0); err != nil {
+		t.Fatalf("aa: unexpected error %v", err)
+	}
+
+	definition.Gain = 3.75
+
+	if !reflect.DeepEqual(definition, result) {
+		t.Errorf("aa: expected %+v, got %+v", definition, result)
+	}
+}
+
+func TestAlternativeFloats(t *testing.T) {
+
+	definition := AB{Half: 1.5, Brain: -2, Single: -118.625, Double: 100, Weights: [2]float32{65504, float32(math.Inf(-1))}}
+
+	bytes := make([]byte, definition.Size())
+
+	if _, err := definition.ToBytes(bytes, 0); err != nil {
+		t.Fatalf("ab: unexpected error %v", err)
+	}
+
+	expected := []byte{
+		0x3E, 0x00,
+		0x00, 0xC0,
+		0xC2, 0x76, 0xA0, 0x00,
+		0x42, 0x64, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x7B, 0xFF, 0xFC, 0x00,
+	}
+
+	if !reflect.DeepEqual(bytes, expected) {
+		t.Errorf("ab: expected bytes %x, got %x", expected, bytes)
+	}
+
+	var result AB
+
+	if _, err := result.FromBytes(bytes, 0); err != nil {
+		t.Fatalf("ab: unexpected error %v", err)
+	}
+
+	if !reflect.DeepEqual(definition, result) {
+		t.Errorf("ab: expected %+v, got %+v", definition, result)
+	}
+}
+
+func TestArbitraryWidthIntegers(t *testing.T) {
+
+	definition := AC{Sample: -2, Timestamp: 0x123456789ABC, Samples: [2]int32{8388607, -8388608}, Wide: -549755813888}
+
+	bytes := make([]byte, definition.Size())
+
+	if _, err := definition.ToBytes(bytes, 0); err != nil {
+		t.Fatalf("ac: unexpected error %v", err)
+	}
+
+	expected := []byte{
+		0xFF, 0xFF, 0xFE,
+		0xBC, 0x9A, 0x78, 0x56, 0x34, 0x12,
+		0x7F, 0xFF, 0xFF, 0x80, 0x00, 0x00,
+		0x80, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x02,
+	}
+
+	if !reflect.DeepEqual(bytes, expected) {
+		t.Errorf("ac: expected bytes %x, got %x", expected, bytes)
+	}
+
+	var result AC
+
+	if _, err := result.FromBytes(bytes, 0); err != nil {
+		t.Fatalf("ac: unexpected error %v", err)
+	}
+
+	definition.Count = 2
+
+	if !reflect.DeepEqual(definition, result) {
+		t.Errorf("ac: expected %+v, got %+v", definition, result)
+	}
+
+	if reflect.TypeOf(result.Sample).Kind() != reflect.Int32 || reflect.TypeOf(result.Timestamp).Kind() != reflect.Uint64 {
+		t.Errorf("ac: unexpected field types %T and %T", result.Sample, result.Timestamp)
+	}
+}
+
+func TestBigIntegers(t *testing.T) {
+
+	amount, _ := new(big.Int).SetString("-1000000000000000000000", 10)
+
+	definition := AD{
+		Hash:     packed.U128{Hi: 0x0102030405060708, Lo: 0x1112131415161718},
+		Delta:    packed.I128{Hi: ^uint64(0), Lo: ^uint64(0)},
+		Amount:   amount,
+		Supply:   big.NewInt(0x0A0B),
+		Balances: [2]*big.Int{big.NewInt(-1), big.NewInt(8388607)},
+	}
+
+	bytes := make([]byte, definition.Size())
+
+	if _, err := definition.ToBytes(bytes, 0); err != nil {
+		t.Fatalf("ad: unexpected error %v", err)
+	}
+
+	expected := []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18}
+	expected = append(expected, slices.Repeat([]byte{0xFF}, 16)...)
+	expected = append(expected, slices.Repeat([]byte{0xFF}, 23)...)
+	expected = append(expected, 0xC9, 0xCA, 0x36, 0x52, 0x3A, 0x21, 0x60, 0x00, 0x00)
+	expected = append(expected, 0x0B, 0x0A, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00)
+	expected = append(expected, 0xFF, 0xFF, 0xFF, 0x7F, 0xFF, 0xFF)
+
+	if !reflect.DeepEqual(bytes, expected) {
+		t.Errorf("ad: expected bytes %x, got %x", expected, bytes)
+	}
+
+	var result AD
+
+	if _, err := result.FromBytes(bytes, 0); err != nil {
+		t.Fatalf("ad: unexpected error %v", err)
+	}
+
+	if result.Hash != definition.Hash || result.Delta.String() != "-1" || result.Amount.Cmp(amount) != 0 || result.Supply.Int64() != 0x0A0B {
+		t.Errorf("ad: expected %+v, got %+v", definition, result)
+	}
+
+	if result.Balances[0].Int64() != -1 || result.Balances[1].Int64() != 8388607 {
+		t.Errorf("ad: expected balances %v, got %v", definition.Balances, result.Balances)
+	}
+
+	definition.Balances[1] = big.NewInt(8388608)
+
+	_, err := definition.ToBytes(bytes, 0)
+
+	var fieldError *packed.FieldError
+
+	if !errors.Is(err, packed.ErrOverflow) || !errors.As(err, &fieldError) || fieldError.Path != "AD.Balances[1]" {
+		t.Errorf("ad: expected overflow of AD.Balances[1], got %v", err)
+	}
+}
+
+func TestVarints(t *testing.T) {
+
+	extra := uint64(1 << 14)
+
+	definition := AE{
+		Type:      0x30,
+		Remaining: 321,
+		ID:        300,
+		Payload:   []byte{0xAA, 0xBB},
+		Delta:     -3,
+		Offset:    -129,
+		Extra:     &extra,
+		Trailer:   0x1234,
+	}
+
+	if size := definition.Size(); size != 18 {
+		t.Errorf("ae: expected size 18, got %d", size)
+	}
+
+	bytes := make([]byte, definition.Size())
+
+	n, err := definition.ToBytes(bytes, 0)
+
+	if err != nil || n != len(bytes) {
+		t.Fatalf("ae: unexpected result %d, %v", n, err)
+	}
+
+	expected := []byte{
+		0x30,
+		0xC1, 0x02,
+		0xAC, 0x02,
+		0x02,
+		0xAA, 0xBB,
+		0x05,
+		0xFF, 0x7E,
+		0x01,
+		0x80, 0x80, 0x01,
+		0x12, 0x34,
+		0x12,
+	}
+
+	if !reflect.DeepEqual(bytes, expected) {
+		t.Errorf("ae: expected bytes %x, got %x", expected, bytes)
+	}
+
+	var result AE
+
+	if n, err := result.FromBytes(bytes, 0); err != nil || n != len(bytes) {
+		t.Fatalf("ae: unexpected result %d, %v", n, err)
+	}
+
+	if !reflect.DeepEqual(definition, result) {
+		t.Errorf("ae: expected %+v, got %+v", definition, result)
+	}
+
+	if _, err := result.FromBytes(bytes[:len(bytes)-1], 0); !errors.Is(err, packed.ErrShortBuffer) || errors.As(err, new(*packed.FieldError)) {
+		t.Errorf("ae: expected short buffer without a field path, got %v", err)
+	}
+
+	if _, err := result.FromBytes(bytes[:2], 0); !errors.Is(err, packed.ErrShortBuffer) {
+		t.Errorf("ae: expected short buffer for truncated varint, got %v", err)
+	}
+
+	definition.Remaining = 1 << 28
+
+	_, err = definition.ToBytes(make([]byte, definition.Size()), 0)
+
+	var fieldError *packed.FieldError
+
+	if !errors.Is(err, packed.ErrOverflow) || !errors.As(err, &fieldError) || fieldError.Path != "AE.Remaining" {
+		t.Errorf("ae: expected overflow of AE.Remaining, got %v", err)
+	}
+}
+
+func TestIntegerEncodings(t *testing.T) {
+
+	definition := AF{Reading: 123456, Offset: -300, Legacy: -1, Position: 5, Seconds: 59, Encoder: 3, Trim: -7}
+
+	bytes := make([]byte, definition.Size())
+
+	if _, err := definition.ToBytes(bytes, 0); err != nil {
+		t.Fatalf("af: unexpected error %v", err)
+	}
+
+	expected := []byte{0x12, 0x34, 0x56, 0x2C, 0x81, 0xFE, 0x00, 0x07, 0x59, 0x2F}
+
+	if !reflect.DeepEqual(bytes, expected) {
+		t.Errorf("af: expected bytes %x, got %x", expected, bytes)
+	}
+
+	var result AF
+
+	if _, err := result.FromBytes(bytes, 0); err != nil {
+		t.Fatalf("af: unexpected error %v", err)
+	}
+
+	if !reflect.DeepEqual(definition, result) {
+		t.Errorf("af: expected %+v, got %+v", definition, result)
+	}
+
+	var fieldError *packed.FieldError
+
+	invalid := slices.Clone(bytes)
+	invalid[8] = 0x5A
+
+	if _, err := result.FromBytes(invalid, 0); !errors.Is(err, packed.ErrInvalidValue) || !errors.As(err, &fieldError) || fieldError.Path != "AF.Seconds" {
+		t.Errorf("af: expected invalid AF.Seconds, got %v", err)
+	}
+
+	invalid = slices.Clone(bytes)
+	invalid[1] = 0x3F
+
+	if _, err := result.FromBytes(invalid, 0); !errors.Is(err, packed.ErrInvalidValue) || !errors.As(err, &fieldError) || fieldError.Path != "AF.Reading" {
+		t.Errorf("af: expected invalid AF.Reading, got %v", err)
+	}
+
+	definition.Trim = 8
+
+	if _, err := definition.ToBytes(bytes, 0); !errors.Is(err, packed.ErrOverflow) || !errors.As(err, &fieldError) || fieldError.Path != "AF.Trim" {
+		t.Errorf("af: expected overflow of AF.Trim, got %v", err)
+	}
+
+	definition.Trim = 0
+	definition.Legacy = 128
+
+	if _, err := definition.ToBytes(bytes, 0); !errors.Is(err, packed.ErrOverflow) || !errors.As(err, &fieldError) || fieldError.Path != "AF.Legacy" {
+		t.Errorf("af: expected overflow of AF.Legacy, got %v", err)
+	}
+}
+
+func TestTimestamps(t *testing.T) {
+
+	moment := time.Date(2024, time.February, 29, 12, 34, 56, 0, time.UTC)
+
+	definition := AG{
+		Created:      moment,
+		Modified:     moment.Add(789 * time.Millisecond),
+		Written:      moment.Add(123456700 * time.Nanosecond),
+		Synchronized: moment.Add(500 * time.Millisecond),
+		Fix:          moment.Add(250 * time.Millisecond),
+		Archived:     moment,
+		Timeout:      2500 * time.Millisecond,
+	}
+
+	bytes := make([]byte, definition.Size())
+
+	if _, err := definition.ToBytes(bytes, 0); err != nil {
+		t.Fatalf("ag: unexpected error %v", err)
+	}
+
+	if !reflect.DeepEqual(bytes[:4], []byte{0x65, 0xE0, 0x79, 0xF0}) || !reflect.DeepEqual(bytes[len(bytes)-2:], []byte{0x00, 0xFA}) {
+		t.Errorf("ag: unexpected bytes %x", bytes)
+	}
+
+	var result AG
+
+	if _, err := result.FromBytes(bytes, 0); err != nil {
+		t.Fatalf("ag: unexpected error %v", err)
+	}
+
+	if !reflect.DeepEqual(definition, result) {
+		t.Errorf("ag: expected %+v, got %+v", definition, result)
+	}
+
+	definition.Created = time.Date(2040, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	_, err := definition.ToBytes(bytes, 0)
+
+	var fieldError *packed.FieldError
+
+	if !errors.Is(err, packed.ErrOverflow) || !errors.As(err, &fieldError) || fieldError.Path != "AG.Created" {
+		t.Errorf("ag: expected overflow of AG.Created, got %v", err)
+	}
+}
+
+func TestUUIDs(t *testing.T) {
+
+	identifier, _ := packed.ParseUUID("00112233-4455-6677-8899-aabbccddeeff")
+	class, _ := packed.ParseUUID("{6B29FC40-CA47-1067-B31D-00DD010662DA}")
+
+	definition := AH{
+		Identifier: identifier,
+		Class:      class,
+		Members:    [2]packed.UUID{class, identifier},
+	}
+
+	bytes := make([]byte, definition.Size())
+
+	if _, err := definition.ToBytes(bytes, 0); err != nil {
+		t.Fatalf("ah: unexpected error %v", err)
+	}
+
+	if !reflect.DeepEqual(bytes[:16], identifier[:]) || !reflect.DeepEqual(bytes[16:24], []byte{0x40, 0xFC, 0x29, 0x6B, 0x47, 0xCA, 0x67, 0x10}) {
+		t.Errorf("ah: unexpected bytes %x", bytes)
+	}
+
+	var result AH
+
+	if _, err := result.FromBytes(bytes, 0); err != nil {
+		t.Fatalf("ah: unexpected error %v", err)
+	}
+
+	if !reflect.DeepEqual(definition, result) {
+		t.Errorf("ah: expected %+v, got %+v", definition, result)
+	}
+
+	encoded, err := json.Marshal(result)
+
+	if err != nil || !strings.Contains(string(encoded), `"Class":"6b29fc40-ca47-1067-b31d-00dd010662da"`) {
+		t.Errorf("ah: unexpected json %s (%v)", encoded, err)
+	}
+
+	var decoded AH
+
+	if err := json.Unmarshal(encoded, &decoded); err != nil || !reflect.DeepEqual(decoded, definition) {
+		t.Errorf("ah: expected %+v, got %+v (%v)", definition, decoded, err)
+	}
+}
+
+func TestNetworkAddresses(t *testing.T) {
+
+	source, _ := packed.ParseMAC("00:1a:2b:3c:4d:5e")
+
+	definition := AI{
+		Source:      source,
+		Destination: net.HardwareAddr{0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF},
+		Gateway:     netip.MustParseAddr("192.168.0.1"),
+		Peer:        netip.MustParseAddrPort("10.0.0.2:53"),
+		Link:        netip.MustParseAddr("fe80::1"),
+	}
+
+	bytes := make([]byte, definition.Size())
+
+	if _, err := definition.ToBytes(bytes, 0); err != nil {
+		t.Fatalf("ai: unexpected error %v", err)
+	}
+
+	if !reflect.DeepEqual(bytes[12:22], []byte{192, 168, 0, 1, 10, 0, 0, 2, 0, 53}) {
+		t.Errorf("ai: unexpected bytes %x", bytes)
+	}
+
+	var result AI
+
+	if _, err := result.FromBytes(bytes, 0); err != nil {
+		t.Fatalf("ai: unexpected error %v", err)
+	}
+
+	if !reflect.DeepEqual(definition, result) {
+		t.Errorf("ai: expected %+v, got %+v", definition, result)
+	}
+
+	copy(bytes[22:], netip.MustParseAddr("::ffff:192.168.0.1").AsSlice())
+
+	_, err := result.FromBytes(bytes, 0)
+
+	var fieldError *packed.FieldError
+
+	if !errors.Is(err, packed.ErrInvalidValue) || !errors.As(err, &fieldError) || fieldError.Path != "AI.Link" {
+		t.Errorf("ai: expected invalid value of AI.Link, got %v", err)
+	}
+}
+
+func TestStringOptions(t *testing.T) {
+
+	definition := AJ{Vendor: "ACME", Label: "disk0", Owner: "Zoë", Title: "Héllo"}
+
+	bytes := make([]byte, definition.Size())
+
+	if _, err := definition.ToBytes(bytes, 0); err != nil {
+		t.Fatalf("aj: unexpected error %v", err)
+	}
+
+	expected := []byte{'A', 'C', 'M', 'E', ' ', ' ', ' ', ' ', 'd', 'i', 's', 'k', '0', 0, 'Z', 'o', 0xEB, 0, 'H', 0, 0xE9, 0, 'l', 0, 'l', 0}
+
+	if !reflect.DeepEqual(bytes, expected) {
+		t.Errorf("aj: expected %x, got %x", expected, bytes)
+	}
+
+	var result AJ
+
+	if _, err := result.FromBytes(bytes, 0); err != nil {
+		t.Fatalf("aj: unexpected error %v", err)
+	}
+
+	if result.Vendor != "ACME" || result.Label != "disk0" || result.Owner != "Zoë" || result.Title != "Héll" {
+		t.Errorf("aj: unexpected result %+v", result)
+	}
+
+	definition.Label = "volume"
+
+	_, err := definition.ToBytes(bytes, 0)
+
+	var fieldError *packed.FieldError
+
+	if !errors.Is(err, packed.ErrOverflow) || !errors.As(err, &fieldError) || fieldError.Path != "AJ.Label" {
+		t.Errorf("aj: expected overflow of AJ.Label, got %v", err)
+	}
+
+	bytes[13] = '!'
+
+	_, err = result.FromBytes(bytes, 0)
+
+	if !errors.Is(err, packed.ErrInvalidValue) || !errors.As(err, &fieldError) || fieldError.Path != "AJ.Label" {
+		t.Errorf("aj: expected invalid value of AJ.Label, got %v", err)
+	}
+}
+
+func TestByteArrays(t *testing.T) {
+
+	definition := AK{
+		Hashes:  [2][4]byte{{1, 2, 3, 4}, {5, 6, 7, 8}},
+		Offsets: [3]int8{-1, 0, 127},
+		Blob:    []uint8{0xDE, 0xAD},
+	}
+
+	for i := range definition.Key {
+		definition.Key[i] = byte(0xF0 + i)
+	}
+
+	bytes := make([]byte, definition.Size())
+
+	if _, err := definition.ToBytes(bytes, 0); err != nil {
+		t.Fatalf("ak: unexpected error %v", err)
+	}
+
+	if !reflect.DeepEqual(bytes[:16], definition.Key[:]) || !reflect.DeepEqual(bytes[16:], []byte{1, 2, 3, 4, 5, 6, 7, 8, 0xFF, 0, 0x7F, 2, 0xDE, 0xAD}) {
+		t.Errorf("ak: unexpected bytes %x", bytes)
+	}
+
+	var result AK
+
+	if _, err := result.FromBytes(bytes, 0); err != nil {
+		t.Fatalf("ak: unexpected error %v", err)
+	}
+
+	if !reflect.DeepEqual(definition, result) {
+		t.Errorf("ak: expected %+v, got %+v", definition, result)
+	}
+}
+
+func TestFixedStrings(t *testing.T) {
+
+	name, err := ParseRecordName("alpha")
+
+	if err != nil {
+		t.Fatalf("al: unexpected error %v", err)
+	}
+
+	tag, _ := ParseRecordTag("abc")
+
+	if truncated, err := ParseRecordTag("abcd"); err != nil || truncated.String() != "abc" {
+		t.Errorf("al: expected abcd to be silently truncated to abc, got %q %v", truncated.String(), err)
+	}
+
+	definition := AL{Name: name, Tags: [2]RecordTag{tag}, Comment: "note", Sequence: 7}
+
+	bytes := make([]byte, definition.Size())
+
+	if _, err := definition.ToBytes(bytes, 0); err != nil {
+		t.Fatalf("al: unexpected error %v", err)
+	}
+
+	if !reflect.DeepEqual(bytes[:16], []byte{'a', 'l', 'p', 'h', 'a', ' ', ' ', ' ', 'a', 'b', 'c', 0, 0, 0, 0, 0}) {
+		t.Errorf("al: unexpected bytes %x", bytes)
+	}
+
+	var result AL
+
+	allocations := testing.AllocsPerRun(100, func() {
+		result.FromBytes(bytes, 0)
+	})
+
+	if allocations != 0 {
+		t.Errorf("al: expected no allocations, got %v", allocations)
+	}
+
+	if result.Name.String() != "alpha" || result.Tags[0].String() != "abc" || result.Tags[1].String() != "" || result.Comment != "note" || result.Sequence != 7 {
+		t.Errorf("al: unexpected result %+v", result)
+	}
+
+	copy(bytes[16:], "memo")
+
+	if result.Comment != "memo" {
+		t.Errorf("al: expected the unsafe comment to alias the buffer, got %q", result.Comment)
+	}
+
+	unterminated := RecordTag{'a', 'b', 'c', 'd'}
+
+	if _, err := unterminated.Value(); !errors.Is(err, packed.ErrInvalidValue) || unterminated.String() != "" {
+		t.Errorf("al: expected an unterminated tag to be invalid, got %q %v", unterminated.String(), err)
+	}
+
+	copy(bytes[12:], "abcd")
+
+	_, err = result.FromBytes(bytes, 0)
+
+	var fieldError *packed.FieldError
+
+	if !errors.Is(err, packed.ErrInvalidValue) || !errors.As(err, &fieldError) || fieldError.Path != "AL.Tags" {
+		t.Errorf("al: expected invalid value for AL.Tags, got %v", err)
+	}
+}
+
+func BenchmarkFixedStringDecode(b *testing.B) {
+
+	name, _ := ParseRecordName("alpha")
+	definition := AL{Name: name, Comment: "note", Sequence: 7}
+
+	bytes := make([]byte, definition.Size())
+	definition.ToBytes(bytes, 0)
+
+	var result AL
+
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		result.FromBytes(bytes, 0)
+	}
+}
+
+func TestScaledValues(t *testing.T) {
+
+	definition := AM{Temperature: 21.5, Pressure: 1013.25, Voltage: 3.3, Current: -12.75, Valid: true, Mode: 5}
+
+	bytes := make([]byte, definition.Size())
+
+	if _, err := definition.ToBytes(bytes, 0); err != nil {
+		t.Fatalf("am: unexpected error %v", err)
+	}
+
+	if !reflect.DeepEqual(bytes, []byte{0x02, 0x67, 0xCD, 0x8B, 0x01, 0x29, 0x4F, 0xCD, 0x85}) {
+		t.Errorf("am: unexpected bytes %x", bytes)
+	}
+
+	var result AM
+
+	if _, err := result.FromBytes(bytes, 0); err != nil {
+		t.Fatalf("am: unexpected error %v", err)
+	}
+
+	if math.Abs(result.Temperature-21.5) > 1e-9 || math.Abs(result.Pressure-1013.25) > 1e-9 || math.Abs(result.Voltage-3.3) > 1e-9 || result.Current != -12.75 || !result.Valid || result.Mode != 5 {
+		t.Errorf("am: unexpected result %+v", result)
+	}
+
+	definition = AM{Temperature: 9000, Pressure: -5, Voltage: 100, Current: -1000}
+
+	if _, err := definition.ToBytes(bytes, 0); err != nil {
+		t.Fatalf("am: unexpected error %v", err)
+	}
+
+	if _, err := result.FromBytes(bytes, 0); err != nil {
+		t.Fatalf("am: unexpected error %v", err)
+	}
+
+	if math.Abs(result.Temperature-3236.7) > 1e-9 || result.Pressure != 0 || math.Abs(result.Voltage-20.475) > 1e-9 || result.Current != -512 {
+		t.Errorf("am: expected clamped values, got %+v", result)
+	}
+}
+
+func TestPixels(t *testing.T) {
+
+	definition := AN{
+		Background: color.RGBA{R: 0xFF, A: 0xFF},
+		Palette:    [2]color.RGBA{{R: 1, G: 2, B: 3, A: 4}, {R: 5, G: 6, B: 7, A: 8}},
+		Tiles:      [2]color.RGBA{{R: 9, G: 10, B: 11, A: 12}},
+		Icon:       [2]color.RGBA{{R: 0xFF, G: 0xFF, B: 0xFF, A: 0xFF}},
+		Left:       color.Gray{Y: 0xFF},
+		Right:      color.Gray{Y: 0x11},
+		Overlay:    color.RGBA{R: 0x11, G: 0x22, B: 0x33, A: 0x44},
+		Pixels:     []color.RGBA{{R: 0xAA, G: 0xBB, B: 0xCC, A: 0xDD}},
+	}
+
+	bytes := make([]byte, definition.Size())
+
+	if _, err := definition.ToBytes(bytes, 0); err != nil {
+		t.Fatalf("an: unexpected error %v", err)
+	}
+
+	expected := []byte{
+		0x00, 0xF8,
+		1, 2, 3, 4, 5, 6, 7, 8,
+		9, 10, 11, 12, 0, 0, 0, 0,
+		0xFF, 0xFF, 0x00, 0x00,
+		0xF1, 0x12, 0x34,
+		1,
+		0xAA, 0xBB, 0xCC, 0xDD,
+	}
+
+	if !reflect.DeepEqual(bytes, expected) {
+		t.Errorf("an: expected %x, got %x", expected, bytes)
+	}
+
+	var result AN
+
+	if _, err := result.FromBytes(bytes, 0); err != nil {
+		t.Fatalf("an: unexpected error %v", err)
+	}
+
+	if !reflect.DeepEqual(definition, result) {
+		t.Errorf("an: expected %+v, got %+v", definition, result)
+	}
+}
+
+func TestMaximalSliceLengths(t *testing.T) {
+
+	varint := []byte{0x30, 0x00, 0x01, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x7F, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00}
+
+	var ae AE
+
+	if _, err := ae.FromBytes(varint, 0); !errors.Is(err, packed.ErrShortBuffer) {
+		t.Errorf("ae: expected short buffer, got %v", err)
+	}
+
+	for _, count := range []uint64{math.MaxInt64, 1 << 62, math.MaxUint64 / 2} {
+
+		bytes := make([]byte, 12)
+		binary.BigEndian.PutUint64(bytes, count)
+
+		var ao AO
+
+		if _, err := ao.FromBytes(bytes, 0); !errors.Is(err, packed.ErrShortBuffer) {
+			t.Errorf("ao: count %d: expected short buffer, got %v", count, err)
+		}
+	}
+
+	bytes := make([]byte, 12)
+	binary.BigEndian.PutUint64(bytes, math.MaxUint64)
+
+	var ao AO
+
+	if _, err := ao.FromBytes(bytes, 0); !errors.Is(err, packed.ErrInvalidLength) {
+		t.Errorf("ao: expected invalid length, got %v", err)
+	}
+}
+
+func TestEnumDiscriminators(t *testing.T) {
+
+	definition := AP{Heading: DirectionLeft, Payload: &QC{A: 0x01020304}, Items: []uint8{7, 8}}
+
+	bytes := make([]byte, definition.Size())
+
+	if _, err := definition.ToBytes(bytes, 0); err != nil {
+		t.Fatalf("ap: unexpected error %v", err)
+	}
+
+	if !reflect.DeepEqual(bytes, []byte{4, 0xC2, 4, 3, 2, 1, 7, 8}) {
+		t.Errorf("ap: unexpected bytes %x", bytes)
+	}
+
+	var result AP
+
+	if _, err := result.FromBytes(bytes, 0); err != nil {
+		t.Fatalf("ap: unexpected error %v", err)
+	}
+
+	if result.Kind != ColorBlue || result.Count != Color(2) || result.Heading != DirectionLeft || !reflect.DeepEqual(result.Payload, definition.Payload) || !reflect.DeepEqual(result.Items, definition.Items) {
+		t.Errorf("ap: unexpected result %+v", result)
+	}
+}
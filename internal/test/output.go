@@ -3,6 +3,14 @@
 package packed
 
 import (
+	"errors"
+	"fmt"
+	"image/color"
+	"math/big"
+	"net"
+	"net/netip"
+	"strings"
+	"time"
 	"unsafe"
 
 	"github.com/0-Mqix/packed"
@@ -10,464 +18,3947 @@ import (
 )
 
 var (
+	// packed.CRCChecksum reflect_in: true reflect_out: true xor_out: 4294967295 width: 32 polynomial: 79764919 init: 4294967295
+	c0 = &packed.CRCChecksum{Polynomial: 0x4C11DB7, Init: 0xFFFFFFFF, ReflectIn: true, ReflectOut: true, XorOut: 0xFFFFFFFF, Width: 32}
+	// packed.UUIDConverter layout: 1
+	c1 = &packed.UUIDConverter{Layout: 1}
+	// packed.IPv4AddrPortConverter
+	c2 = &packed.IPv4AddrPortConverter{}
+	// packed.TextConverter reject_truncation: false encoding: 0 validate_utf8: false zero_copy: false length: 4 pad: 0 terminated: true
+	c3 = &packed.TextConverter{NullTerminated: true, RejectTruncation: false, Encoding: 0, ValidateUTF8: false, ZeroCopy: false, Length: 4, Pad: 0}
+	// packed.Int32Converter
+	c4 = &packed.Int32Converter{}
+	// packed.IPv4Converter
+	c5 = &packed.IPv4Converter{}
+	// packed.BCDConverter strict: true digits: 6
+	c6 = &packed.BCDConverter{Digits: 6, StrictDecode: true}
+	// packed.BooleanConverter
+	c7 = &packed.BooleanConverter{}
+	// packed.XorChecksum
+	c8 = &packed.XorChecksum{}
+	// packed.BigIntConverter bytes: 8 signed: false
+	c9 = &packed.BigIntConverter{Signed: false, Bytes: 8}
+	// packed.MQTTVarintConverter
+	c10 = &packed.MQTTVarintConverter{}
+	// packed.SignedLEB128Converter
+	c11 = &packed.SignedLEB128Converter{}
+	// packed.UUIDConverter layout: 0
+	c12 = &packed.UUIDConverter{Layout: 0}
+	// packed.TextConverter reject_truncation: true encoding: 0 validate_utf8: false zero_copy: false length: 6 pad: 0 terminated: true
+	c13 = &packed.TextConverter{NullTerminated: true, RejectTruncation: true, Encoding: 0, ValidateUTF8: false, ZeroCopy: false, Length: 6, Pad: 0}
+	// packed.ScaledConverter[int16] raw: _cGFja2VkLkludDE2Q29udmVydGVy bits: 16 factor: 0.1 offset: -40
+	c14 = &packed.ScaledConverter[int16]{Factor: 0.1, Offset: -40, Raw: &packed.Int16Converter{}, RawHash: "_cGFja2VkLkludDE2Q29udmVydGVy", Bits: 16}
+	// packed.FixedPointConverter signed: true bits: 16 scale: 32768
+	c15 = &packed.FixedPointConverter{Bits: 16, Scale: 32768, Signed: true}
+	// packed.BigIntConverter signed: true bytes: 32
+	c16 = &packed.BigIntConverter{Bytes: 32, Signed: true}
+	// packed.TimestampConverter resolution: 1s bytes: 4 signed: true epoch: 0
+	c17 = &packed.TimestampConverter{Bytes: 4, Signed: true, Epoch: 0, Resolution: 1000000000}
+	// packed.Int16Converter
+	c18 = &packed.Int16Converter{}
+	// packed.VarintConverter
+	c19 = &packed.VarintConverter{}
+	// packed.Uint64Converter
+	c20 = &packed.Uint64Converter{}
+	// packed.IPv6Converter only_ipv6: true
+	c21 = &packed.IPv6Converter{OnlyIPv6: true}
+	// packed.TextConverter length: 8 pad: 32 terminated: false reject_truncation: false encoding: 0 validate_utf8: false zero_copy: false
+	c22 = &packed.TextConverter{Pad: 32, NullTerminated: false, RejectTruncation: false, Encoding: 0, ValidateUTF8: false, ZeroCopy: false, Length: 8}
+	// packed.IntConverter[int32] bytes: 3
+	c23 = &packed.IntConverter[int32]{Bytes: 3}
+	// packed.Float64Converter
+	c24 = &packed.Float64Converter{}
+	// packed.FixedPointConverter scale: 100 signed: true bits: 16
+	c25 = &packed.FixedPointConverter{Bits: 16, Scale: 100, Signed: true}
+	// packed.FixedPointConverter bits: 4 scale: 4 signed: false
+	c26 = &packed.FixedPointConverter{Bits: 4, Scale: 4, Signed: false}
+	// packed.BFloat16Converter
+	c27 = &packed.BFloat16Converter{}
+	// packed.TextConverter zero_copy: false length: 4 pad: 0 terminated: false reject_truncation: false encoding: 2 validate_utf8: true
+	c28 = &packed.TextConverter{NullTerminated: false, RejectTruncation: false, Encoding: 2, ValidateUTF8: true, ZeroCopy: false, Length: 4, Pad: 0}
+	// packed.PixelConverter red_shift: 10 green_bits: 5 green_shift: 5 blue_shift: 0 alpha_shift: 15 blue_bits: 5 alpha_bits: 1 bits: 16 red_bits: 5
+	c29 = &packed.PixelConverter{Bits: 16, GreenBits: 5, GreenShift: 5, BlueShift: 0, AlphaBits: 1, RedBits: 5, RedShift: 10, BlueBits: 5, AlphaShift: 15}
+	// packed.Uint16Converter
+	c30 = &packed.Uint16Converter{}
 	// types.ExampleBitsTypeConverter
-	c0 = &types.ExampleBitsTypeConverter{}
-	// packed.Uint32Converter
-	c1 = &packed.Uint32Converter{}
+	c31 = &types.ExampleBitsTypeConverter{}
+	// packed.UintConverter[uint64] bytes: 6
+	c32 = &packed.UintConverter[uint64]{Bytes: 6}
+	// packed.IntConverter[int64] bytes: 5
+	c33 = &packed.IntConverter[int64]{Bytes: 5}
+	// packed.SignMagnitudeConverter bits: 16
+	c34 = &packed.SignMagnitudeConverter{Bits: 16}
+	// packed.BCDConverter digits: 2 strict: true
+	c35 = &packed.BCDConverter{Digits: 2, StrictDecode: true}
+	// packed.SignMagnitudeConverter bits: 4
+	c36 = &packed.SignMagnitudeConverter{Bits: 4}
+	// packed.TimestampConverter resolution: 100ns bytes: 8 signed: false epoch: -11644473600
+	c37 = &packed.TimestampConverter{Resolution: 100, Bytes: 8, Signed: false, Epoch: -11644473600}
 	// packed.Int8Converter
-	c2 = &packed.Int8Converter{}
-	// packed.Uint8Converter
-	c3 = &packed.Uint8Converter{}
-	// packed.Uint16Converter
-	c4 = &packed.Uint16Converter{}
+	c38 = &packed.Int8Converter{}
+	// packed.TimestampConverter bytes: 8 signed: true epoch: 0 resolution: 1ms
+	c39 = &packed.TimestampConverter{Epoch: 0, Resolution: 1000000, Bytes: 8, Signed: true}
+	// packed.GPSTimeConverter week_bytes: 2 time_of_week_bytes: 4 epoch: 315964800 resolution: 1ms leap_seconds: 0
+	c40 = &packed.GPSTimeConverter{WeekBytes: 2, TimeOfWeekBytes: 4, Epoch: 315964800, Resolution: 1000000, LeapSeconds: 0}
+	// packed.DOSTimestampConverter epoch_year: 1980
+	c41 = &packed.DOSTimestampConverter{EpochYear: 1980}
+	// packed.GrayscaleConverter bits: 4
+	c42 = &packed.GrayscaleConverter{Bits: 4}
+	// packed.Uint32Converter
+	c43 = &packed.Uint32Converter{}
 	// packed.Int64Converter
-	c5 = &packed.Int64Converter{}
-	// types.ExampleConverter
-	c6 = &types.ExampleConverter{}
-	// packed.Int16Converter
-	c7 = &packed.Int16Converter{}
-	// packed.Int32Converter
-	c8 = &packed.Int32Converter{}
+	c44 = &packed.Int64Converter{}
 	// packed.StringConverter length: 1
-	c9 = &packed.StringConverter{Length: 1}
+	c45 = &packed.StringConverter{Length: 1}
+	// packed.OnesComplementConverter bits: 8
+	c46 = &packed.OnesComplementConverter{Bits: 8}
+	// packed.GrayConverter bits: 12
+	c47 = &packed.GrayConverter{Bits: 12}
+	// packed.ScaledConverter[uint16] bits: 12 factor: 0.005 offset: 0 raw:
+	c48 = &packed.ScaledConverter[uint16]{RawHash: "", Bits: 12, Factor: 0.005, Offset: 0}
+	// packed.Uint8Converter
+	c49 = &packed.Uint8Converter{}
+	// packed.FixedPointConverter signed: true bits: 12 scale: 16
+	c50 = &packed.FixedPointConverter{Signed: true, Bits: 12, Scale: 16}
+	// packed.ZigzagVarintConverter
+	c51 = &packed.ZigzagVarintConverter{}
+	// packed.HardwareAddrConverter
+	c52 = &packed.HardwareAddrConverter{}
+	// packed.PixelConverter red_bits: 8 red_shift: 24 blue_bits: 8 bits: 32 green_bits: 8 green_shift: 16 blue_shift: 8 alpha_bits: 8 alpha_shift: 0
+	c53 = &packed.PixelConverter{GreenBits: 8, AlphaBits: 8, Bits: 32, RedBits: 8, RedShift: 24, GreenShift: 16, BlueBits: 8, BlueShift: 8, AlphaShift: 0}
+	// packed.PixelConverter red_bits: 4 green_bits: 4 green_shift: 8 blue_bits: 4 red_shift: 12 blue_shift: 4 alpha_bits: 4 alpha_shift: 0 bits: 16
+	c54 = &packed.PixelConverter{AlphaBits: 4, AlphaShift: 0, Bits: 16, RedBits: 4, GreenBits: 4, BlueShift: 4, RedShift: 12, GreenShift: 8, BlueBits: 4}
+	// packed.CRCChecksum polynomial: 7 init: 0 reflect_in: false reflect_out: false xor_out: 0 width: 8
+	c55 = &packed.CRCChecksum{Width: 8, Polynomial: 0x7, Init: 0x0, ReflectIn: false, ReflectOut: false, XorOut: 0x0}
+	// packed.Float32Converter
+	c56 = &packed.Float32Converter{}
+	// packed.FixedPointConverter bits: 32 scale: 65536 signed: true
+	c57 = &packed.FixedPointConverter{Bits: 32, Scale: 65536, Signed: true}
+	// packed.Float16Converter
+	c58 = &packed.Float16Converter{}
+	// packed.Int128Converter
+	c59 = &packed.Int128Converter{}
+	// packed.TextConverter validate_utf8: false zero_copy: false length: 4 pad: 0 terminated: false reject_truncation: false encoding: 1
+	c60 = &packed.TextConverter{Encoding: 1, ValidateUTF8: false, ZeroCopy: false, Length: 4, Pad: 0, NullTerminated: false, RejectTruncation: false}
+	// packed.SumChecksum width: 2
+	c61 = &packed.SumChecksum{Width: 2}
+	// packed.IBMFloat32Converter
+	c62 = &packed.IBMFloat32Converter{}
+	// packed.Uint128Converter
+	c63 = &packed.Uint128Converter{}
+	// packed.NTPConverter epoch: -2208988800
+	c64 = &packed.NTPConverter{Epoch: -2208988800}
+	// packed.DurationConverter bytes: 2 signed: false resolution: 10ms
+	c65 = &packed.DurationConverter{Bytes: 2, Signed: false, Resolution: 10000000}
+	// types.ExampleConverter
+	c66 = &types.ExampleConverter{}
+	// packed.StringConverter length: 4
+	c67 = &packed.StringConverter{Length: 4}
+	// packed.IBMFloat64Converter
+	c68 = &packed.IBMFloat64Converter{}
+	// packed.UintConverter[uint32] bytes: 3
+	c69 = &packed.UintConverter[uint32]{Bytes: 3}
+	// packed.GrayConverter bits: 4
+	c70 = &packed.GrayConverter{Bits: 4}
+	// packed.MACConverter
+	c71 = &packed.MACConverter{}
+	// packed.ScaledConverter[uint32] bits: 24 factor: 0.01 offset: 0 raw: _cGFja2VkLlVpbnRDb252ZXJ0ZXJbdWludDMyXWJ5dGVzOjM
+	c72 = &packed.ScaledConverter[uint32]{RawHash: "_cGFja2VkLlVpbnRDb252ZXJ0ZXJbdWludDMyXWJ5dGVzOjM", Bits: 24, Factor: 0.01, Offset: 0, Raw: &packed.UintConverter[uint32]{Bytes: 3}}
+	// packed.PixelConverter red_shift: 11 blue_bits: 5 alpha_shift: 0 green_bits: 6 green_shift: 5 blue_shift: 0 alpha_bits: 0 bits: 16 red_bits: 5
+	c73 = &packed.PixelConverter{GreenBits: 6, BlueShift: 0, AlphaShift: 0, Bits: 16, RedBits: 5, GreenShift: 5, BlueBits: 5, AlphaBits: 0, RedShift: 11}
+	// packed.BigIntConverter bytes: 3 signed: true
+	c74 = &packed.BigIntConverter{Bytes: 3, Signed: true}
+	// packed.TextConverter reject_truncation: false encoding: 0 validate_utf8: false zero_copy: true length: 8 pad: 0 terminated: false
+	c75 = &packed.TextConverter{Length: 8, Pad: 0, NullTerminated: false, RejectTruncation: false, Encoding: 0, ValidateUTF8: false, ZeroCopy: true}
+	// packed.ScaledConverter[int16] bits: 12 factor: 0.25 offset: 0 raw:
+	c76 = &packed.ScaledConverter[int16]{RawHash: "", Bits: 12, Factor: 0.25, Offset: 0}
+	// packed.PixelConverter blue_bits: 8 blue_shift: 16 alpha_bits: 8 red_shift: 0 green_bits: 8 alpha_shift: 24 bits: 32 red_bits: 8 green_shift: 8
+	c77 = &packed.PixelConverter{GreenBits: 8, GreenShift: 8, AlphaShift: 24, RedShift: 0, BlueBits: 8, BlueShift: 16, AlphaBits: 8, Bits: 32, RedBits: 8}
 )
 
-type L struct {
+type Color uint8
+
+const (
+	ColorRed   Color = 1
+	ColorGreen Color = 2
+	ColorBlue  Color = 4
+)
+
+func (e Color) String() string {
+	switch e {
+	case ColorRed:
+		return "Red"
+	case ColorGreen:
+		return "Green"
+	case ColorBlue:
+		return "Blue"
+	}
+	return fmt.Sprintf("Color(%d)", uint8(e))
+}
+
+func (e Color) IsValid() bool {
+	switch e {
+	case ColorRed, ColorGreen, ColorBlue:
+		return true
+	}
+	return false
+}
+
+func ParseColor(value string) (Color, error) {
+	switch value {
+	case "Red":
+		return ColorRed, nil
+	case "Green":
+		return ColorGreen, nil
+	case "Blue":
+		return ColorBlue, nil
+	}
+	return 0, fmt.Errorf("%w: %q is not a valid Color", packed.ErrInvalidValue, value)
+}
+
+type Direction int16
+
+const (
+	DirectionLeft  Direction = -1
+	DirectionNone  Direction = 0
+	DirectionRight Direction = 1
+)
+
+func (e Direction) String() string {
+	switch e {
+	case DirectionLeft:
+		return "Left"
+	case DirectionNone:
+		return "None"
+	case DirectionRight:
+		return "Right"
+	}
+	return fmt.Sprintf("Direction(%d)", int16(e))
+}
+
+func (e Direction) IsValid() bool {
+	switch e {
+	case DirectionLeft, DirectionNone, DirectionRight:
+		return true
+	}
+	return false
+}
+
+func ParseDirection(value string) (Direction, error) {
+	switch value {
+	case "Left":
+		return DirectionLeft, nil
+	case "None":
+		return DirectionNone, nil
+	case "Right":
+		return DirectionRight, nil
+	}
+	return 0, fmt.Errorf("%w: %q is not a valid Direction", packed.ErrInvalidValue, value)
+}
+
+type Status uint8
+
+const (
+	StatusReady Status = 1 << 0
+	StatusError Status = 1 << 1
+	StatusBusy  Status = 1 << 3
+)
+
+func (f Status) Has(flag Status) bool {
+	return f&flag == flag
+}
+
+func (f *Status) Set(flag Status) {
+	*f |= flag
+}
+
+func (f *Status) Clear(flag Status) {
+	*f &^= flag
+}
+
+func (f Status) String() string {
+	if f == 0 {
+		return "0"
+	}
+	names := []string{}
+	if f&StatusReady != 0 {
+		names = append(names, "Ready")
+	}
+	if f&StatusError != 0 {
+		names = append(names, "Error")
+	}
+	if f&StatusBusy != 0 {
+		names = append(names, "Busy")
+	}
+	if unknown := f &^ (StatusReady | StatusError | StatusBusy); unknown != 0 {
+		names = append(names, fmt.Sprintf("0x%X", uint8(unknown)))
+	}
+	return strings.Join(names, "|")
+}
+
+type Features uint16
+
+const (
+	FeaturesWide Features = 1 << 0
+	FeaturesFast Features = 1 << 1
+)
+
+func (f Features) Has(flag Features) bool {
+	return f&flag == flag
+}
+
+func (f *Features) Set(flag Features) {
+	*f |= flag
+}
+
+func (f *Features) Clear(flag Features) {
+	*f &^= flag
+}
+
+func (f Features) String() string {
+	if f == 0 {
+		return "0"
+	}
+	names := []string{}
+	if f&FeaturesWide != 0 {
+		names = append(names, "Wide")
+	}
+	if f&FeaturesFast != 0 {
+		names = append(names, "Fast")
+	}
+	if unknown := f &^ (FeaturesWide | FeaturesFast); unknown != 0 {
+		names = append(names, fmt.Sprintf("0x%X", uint16(unknown)))
+	}
+	return strings.Join(names, "|")
+}
+
+// RecordName is a 8 byte fixed string, FromBytes rejects bytes that do not decode and String returns an empty string for them.
+type RecordName [8]byte
+
+func (s RecordName) Value() (string, error) {
+	var value string
+	err := c22.FromBytesLittleEndian(&value, s[:], 0)
+	return value, err
+}
+
+func (s RecordName) String() string {
+	value, _ := s.Value()
+	return value
+}
+
+func ParseRecordName(value string) (RecordName, error) {
+	var s RecordName
+	err := c22.ToBytesLittleEndian(&value, s[:], 0)
+	return s, err
+}
+
+// RecordTag is a 4 byte fixed string, FromBytes rejects bytes that do not decode and String returns an empty string for them.
+type RecordTag [4]byte
+
+func (s RecordTag) Value() (string, error) {
+	var value string
+	err := c3.FromBytesLittleEndian(&value, s[:], 0)
+	return value, err
+}
+
+func (s RecordTag) String() string {
+	value, _ := s.Value()
+	return value
+}
+
+func ParseRecordTag(value string) (RecordTag, error) {
+	var s RecordTag
+	err := c3.ToBytesLittleEndian(&value, s[:], 0)
+	return s, err
+}
+
+// S is 40 bytes, little endian, with 8 byte alignment.
+//
+//	offset  size  field
+//	0       1     A
+//	1       3     (padding)
+//	4       4     B
+//	8       1     C (3 bits)
+//	9       7     (padding)
+//	16      8     D
+//	24      12    E
+//	36      1     F
+//	37      3     (padding)
+type S struct {
 	A uint8
-	B [10]bool
+	B int32
+	C uint16
+	D float64
+	E [3]SA
+	F uint8
 }
 
-func (reciever *L) Size() int {
+func (reciever *S) Size() int {
+	return 40
+}
+
+func (reciever *S) ToBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+40 {
+		return 0, packed.ErrShortBuffer
+	}
+	c49.ToBytesLittleEndian(&reciever.A, bytes, index+0)
+	clear(bytes[index+1 : index+1+3])
+	c4.ToBytesLittleEndian(&reciever.B, bytes, index+4)
+	var b0 uint64
+	b0 |= (uint64(reciever.C) & 0x7)
+	bytes[index+8+0] = byte(b0 >> 0)
+	clear(bytes[index+9 : index+9+7])
+	c24.ToBytesLittleEndian(&reciever.D, bytes, index+16)
+	o24 := index + 24
+	for i0 := 0; i0 < 3; i0++ {
+		c49.ToBytesLittleEndian(&reciever.E[i0].A, bytes, o24)
+		o24 += 1
+		clear(bytes[o24 : o24+1])
+		o24 += 1
+		c30.ToBytesLittleEndian(&reciever.E[i0].B, bytes, o24)
+		o24 += 2
+	}
+	c49.ToBytesLittleEndian(&reciever.F, bytes, index+36)
+	clear(bytes[index+37 : index+37+3])
+	return 40, nil
+}
+
+func (reciever *S) FromBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+40 {
+		return 0, packed.ErrShortBuffer
+	}
+	c49.FromBytesLittleEndian(&reciever.A, bytes, index+0)
+	c4.FromBytesLittleEndian(&reciever.B, bytes, index+4)
+	var b0 uint64
+	b0 |= uint64(bytes[index+8+0]) << 0
+	reciever.C = uint16(uint64((b0 >> 0) & 0x7))
+	c24.FromBytesLittleEndian(&reciever.D, bytes, index+16)
+	o24 := index + 24
+	for i0 := 0; i0 < 3; i0++ {
+		c49.FromBytesLittleEndian(&reciever.E[i0].A, bytes, o24)
+		o24 += 1
+		o24 += 1
+		c30.FromBytesLittleEndian(&reciever.E[i0].B, bytes, o24)
+		o24 += 2
+	}
+	c49.FromBytesLittleEndian(&reciever.F, bytes, index+36)
+	return 40, nil
+}
+
+// V is at least 11 bytes, big endian, with 1 byte alignment.
+//
+//	offset  size      field
+//	0       4         Header
+//	4       variable  Payload
+//	4+      1         Parity (checksum of Payload..Payload)
+//	5+      2         Sum (checksum of Header..Payload)
+//	7+      4         CRC (checksum of start..here)
+type V struct {
+	Header  VA
+	Payload []byte
+	Parity  uint8
+	Sum     uint16
+	CRC     uint32
+}
+
+func (reciever *V) Size() int {
+	size := 11
+	size += len(reciever.Payload)
+	return size
+}
+
+func (reciever *V) ToBytes(bytes []byte, index int) (int, error) {
+	if uint64(len(reciever.Payload)) > 65535 {
+		return 0, &packed.FieldError{Path: "V.Payload", Err: packed.ErrInvalidLength}
+	}
+	reciever.Header.Length = uint16(len(reciever.Payload))
+	if len(bytes) < index+reciever.Size() {
+		return 0, packed.ErrShortBuffer
+	}
+	start := index
+	checksumStartVSum := index + 0
+	checksumStartVCRC := index + 0
+	checksumStartVHeaderCRC := index + 0
+	c30.ToBytesBigEndian(&reciever.Header.Length, bytes, index+0)
+	c49.ToBytesBigEndian(&reciever.Header.Kind, bytes, index+2)
+	checksumEndVHeaderCRC := index + 3
+	checksumIndexVHeaderCRC := index + 3
+	checksumStartVParity := index + 4
+	index += 4
+	copy(bytes[index:], reciever.Payload)
+	index += len(reciever.Payload)
+	checksumEndVParity := index + 0
+	checksumEndVSum := index + 0
+	checksumIndexVParity := index + 0
+	checksumIndexVSum := index + 1
+	checksumEndVCRC := index + 3
+	checksumIndexVCRC := index + 3
+	reciever.Header.CRC = uint8(c55.Checksum(bytes[checksumStartVHeaderCRC:checksumEndVHeaderCRC]))
+	bytes[checksumIndexVHeaderCRC+0] = byte(reciever.Header.CRC)
+	reciever.Parity = uint8(c8.Checksum(bytes[checksumStartVParity:checksumEndVParity]))
+	bytes[checksumIndexVParity+0] = byte(reciever.Parity)
+	reciever.Sum = uint16(c61.Checksum(bytes[checksumStartVSum:checksumEndVSum]))
+	bytes[checksumIndexVSum+0] = byte(reciever.Sum)
+	bytes[checksumIndexVSum+1] = byte(reciever.Sum >> 8)
+	reciever.CRC = uint32(c0.Checksum(bytes[checksumStartVCRC:checksumEndVCRC]))
+	bytes[checksumIndexVCRC+0] = byte(reciever.CRC >> 24)
+	bytes[checksumIndexVCRC+1] = byte(reciever.CRC >> 16)
+	bytes[checksumIndexVCRC+2] = byte(reciever.CRC >> 8)
+	bytes[checksumIndexVCRC+3] = byte(reciever.CRC)
+	index += 7
+	return index - start, nil
+}
+
+func (reciever *V) FromBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+11 {
+		return 0, packed.ErrShortBuffer
+	}
+	start := index
+	checksumStartVSum := index + 0
+	checksumStartVCRC := index + 0
+	checksumStartVHeaderCRC := index + 0
+	c30.FromBytesBigEndian(&reciever.Header.Length, bytes, index+0)
+	c49.FromBytesBigEndian(&reciever.Header.Kind, bytes, index+2)
+	checksumEndVHeaderCRC := index + 3
+	reciever.Header.CRC = uint8(bytes[index+3+0])
+	checksumStartVParity := index + 4
+	index += 4
+	if available := len(bytes) - index - 7; available < 0 || uint64(int(reciever.Header.Length)) > uint64(available) {
+		return 0, &packed.FieldError{Path: "V.Payload", Err: packed.ErrShortBuffer}
+	}
+	reciever.Payload = make([]byte, int(reciever.Header.Length))
+	copy(reciever.Payload, bytes[index:])
+	index += len(reciever.Payload)
+	checksumEndVParity := index + 0
+	checksumEndVSum := index + 0
+	reciever.Parity = uint8(bytes[index+0+0])
+	reciever.Sum = uint16(bytes[index+1+0]) | uint16(bytes[index+1+1])<<8
+	checksumEndVCRC := index + 3
+	reciever.CRC = uint32(bytes[index+3+0])<<24 | uint32(bytes[index+3+1])<<16 | uint32(bytes[index+3+2])<<8 | uint32(bytes[index+3+3])
+	if checksum := uint8(c55.Checksum(bytes[checksumStartVHeaderCRC:checksumEndVHeaderCRC])); checksum != reciever.Header.CRC {
+		return 0, &packed.FieldError{Path: "V.Header.CRC", Err: fmt.Errorf("%w: expected 0x%X, got 0x%X", packed.ErrChecksumMismatch, checksum, reciever.Header.CRC)}
+	}
+	if checksum := uint8(c8.Checksum(bytes[checksumStartVParity:checksumEndVParity])); checksum != reciever.Parity {
+		return 0, &packed.FieldError{Path: "V.Parity", Err: fmt.Errorf("%w: expected 0x%X, got 0x%X", packed.ErrChecksumMismatch, checksum, reciever.Parity)}
+	}
+	if checksum := uint16(c61.Checksum(bytes[checksumStartVSum:checksumEndVSum])); checksum != reciever.Sum {
+		return 0, &packed.FieldError{Path: "V.Sum", Err: fmt.Errorf("%w: expected 0x%X, got 0x%X", packed.ErrChecksumMismatch, checksum, reciever.Sum)}
+	}
+	if checksum := uint32(c0.Checksum(bytes[checksumStartVCRC:checksumEndVCRC])); checksum != reciever.CRC {
+		return 0, &packed.FieldError{Path: "V.CRC", Err: fmt.Errorf("%w: expected 0x%X, got 0x%X", packed.ErrChecksumMismatch, checksum, reciever.CRC)}
+	}
+	index += 7
+	return index - start, nil
+}
+
+// WA is 10 bytes, little endian, with 1 byte alignment.
+//
+//	offset  size  field
+//	0       1     Kind
+//	1       1     Entries (4 bits) (count of Values), Flags (4 bits)
+//	2       8     Values
+type WA struct {
+	Kind    uint8
+	Entries uint8
+	Flags   uint8
+	Values  [4]uint16
+}
+
+func (reciever *WA) Size() int {
+	return 10
+}
+
+func (reciever *WA) ToBytes(bytes []byte, index int) (int, error) {
+	{
+		count := 0
+		var zero uint16
+		for _, element := range reciever.Values {
+			if element != zero {
+				count++
+			}
+		}
+		if uint64(count) > 15 {
+			return 0, &packed.FieldError{Path: "WA.Entries", Err: packed.ErrInvalidLength}
+		}
+		reciever.Entries = uint8(count)
+	}
+	if len(bytes) < index+10 {
+		return 0, packed.ErrShortBuffer
+	}
+	c49.ToBytesLittleEndian(&reciever.Kind, bytes, index+0)
+	var b0 uint64
+	b0 |= (uint64(reciever.Entries) & 0xF)
+	b0 |= (uint64(reciever.Flags) & 0xF) << 4
+	bytes[index+1+0] = byte(b0 >> 0)
+	o2 := index + 2
+	for i0 := 0; i0 < 4; i0++ {
+		c30.ToBytesLittleEndian(&reciever.Values[i0], bytes, o2)
+		o2 += 2
+	}
+	return 10, nil
+}
+
+func (reciever *WA) FromBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+10 {
+		return 0, packed.ErrShortBuffer
+	}
+	c49.FromBytesLittleEndian(&reciever.Kind, bytes, index+0)
+	var b0 uint64
+	b0 |= uint64(bytes[index+1+0]) << 0
+	reciever.Entries = uint8(uint64((b0 >> 0) & 0xF))
+	reciever.Flags = uint8(uint64((b0 >> 4) & 0xF))
+	o2 := index + 2
+	for i0 := 0; i0 < 4; i0++ {
+		c30.FromBytesLittleEndian(&reciever.Values[i0], bytes, o2)
+		o2 += 2
+	}
+	{
+		count := 0
+		var zero uint16
+		for _, element := range reciever.Values {
+			if element != zero {
+				count++
+			}
+		}
+		if uint64(count) != uint64(reciever.Entries) {
+			return 0, &packed.FieldError{Path: "WA.Entries", Err: fmt.Errorf("%w: expected %d, got %d", packed.ErrComputedMismatch, count, reciever.Entries)}
+		}
+	}
+	return 10, nil
+}
+
+// XA is 2 bytes, little endian, with 1 byte alignment.
+//
+//	offset  size  field
+//	0       1     A
+//	1       1     B (3 bits), C (5 bits)
+type XA struct {
+	A uint8
+	B uint8
+	C uint8
+}
+
+func (reciever *XA) Size() int {
 	return 2
 }
 
-func (reciever *L) ToBytes(bytes []byte, index int) {
+func (reciever *XA) ToBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+2 {
+		return 0, packed.ErrShortBuffer
+	}
+	c49.ToBytesLittleEndian(&reciever.A, bytes, index+0)
 	var b0 uint64
-	b0 |= (uint64(reciever.A) & 0xF)
-	b0 |= (uint64(c0.Integer(&reciever.B)) & 0x3FF) << 4
+	b0 |= (uint64(reciever.B) & 0x7)
+	b0 |= (uint64(reciever.C) & 0x1F) << 3
+	bytes[index+1+0] = byte(b0 >> 0)
+	return 2, nil
+}
+
+func (reciever *XA) FromBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+2 {
+		return 0, packed.ErrShortBuffer
+	}
+	c49.FromBytesLittleEndian(&reciever.A, bytes, index+0)
+	var b0 uint64
+	b0 |= uint64(bytes[index+1+0]) << 0
+	reciever.B = uint8(uint64((b0 >> 0) & 0x7))
+	reciever.C = uint8(uint64((b0 >> 3) & 0x1F))
+	return 2, nil
+}
+
+func (reciever *XA) Validate() error {
+	if reciever.A < 1 || reciever.A > 10 {
+		return &packed.FieldError{Path: "XA.A", Err: fmt.Errorf("%w: %v is not between %v and %v", packed.ErrInvalidValue, reciever.A, 1, 10)}
+	}
+	switch reciever.B {
+	case 1, 2, 4:
+	default:
+		return &packed.FieldError{Path: "XA.B", Err: fmt.Errorf("%w: %v is not one of 1, 2, 4", packed.ErrInvalidValue, reciever.B)}
+	}
+	return nil
+}
+
+// SA is 4 bytes, little endian, with 2 byte alignment.
+//
+//	offset  size  field
+//	0       1     A
+//	1       1     (padding)
+//	2       2     B
+type SA struct {
+	A uint8
+	B uint16
+}
+
+func (reciever *SA) Size() int {
+	return 4
+}
+
+func (reciever *SA) ToBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+4 {
+		return 0, packed.ErrShortBuffer
+	}
+	c49.ToBytesLittleEndian(&reciever.A, bytes, index+0)
+	clear(bytes[index+1 : index+1+1])
+	c30.ToBytesLittleEndian(&reciever.B, bytes, index+2)
+	return 4, nil
+}
+
+func (reciever *SA) FromBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+4 {
+		return 0, packed.ErrShortBuffer
+	}
+	c49.FromBytesLittleEndian(&reciever.A, bytes, index+0)
+	c30.FromBytesLittleEndian(&reciever.B, bytes, index+2)
+	return 4, nil
+}
+
+// Z is 3 bytes, little endian, with 1 byte alignment.
+//
+//	offset  size  field
+//	0       3     Status (6 bits), Mode (2 bits), Features (12 bits), (reserved 4 bits)
+type Z struct {
+	Status   Status
+	Mode     uint8
+	Features Features
+}
+
+func (reciever *Z) Size() int {
+	return 3
+}
+
+func (reciever *Z) ToBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+3 {
+		return 0, packed.ErrShortBuffer
+	}
+	var b0 uint64
+	b0 |= (uint64(reciever.Status) & 0x3F)
+	b0 |= (uint64(reciever.Mode) & 0x3) << 6
+	b0 |= (uint64(reciever.Features) & 0xFFF) << 8
 	bytes[index+0+0] = byte(b0 >> 0)
 	bytes[index+0+1] = byte(b0 >> 8)
+	bytes[index+0+2] = byte(b0 >> 16)
+	return 3, nil
 }
 
-func (reciever *L) FromBytes(bytes []byte, index int) {
+func (reciever *Z) FromBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+3 {
+		return 0, packed.ErrShortBuffer
+	}
 	var b0 uint64
 	b0 |= uint64(bytes[index+0+0]) << 0
 	b0 |= uint64(bytes[index+0+1]) << 8
-	reciever.A = uint8(uint64((b0 >> 0) & 0xF))
-	c0.Set(&reciever.B, uint16(uint64((b0>>4)&0x3FF)))
+	b0 |= uint64(bytes[index+0+2]) << 16
+	reciever.Status = Status(uint64((b0 >> 0) & 0x3F))
+	reciever.Mode = uint8(uint64((b0 >> 6) & 0x3))
+	reciever.Features = Features(uint64((b0 >> 8) & 0xFFF))
+	return 3, nil
 }
 
-type M struct {
-	A [2]L
-	B [2]K
+// AB is 20 bytes, big endian, with 1 byte alignment.
+//
+//	offset  size  field
+//	0       2     Half
+//	2       2     Brain
+//	4       4     Single
+//	8       8     Double
+//	16      4     Weights
+type AB struct {
+	Half    float32
+	Brain   float32
+	Single  float64
+	Double  float64
+	Weights [2]float32
 }
 
-func (reciever *M) Size() int {
-	return 8
+func (reciever *AB) Size() int {
+	return 20
 }
 
-func (reciever *M) ToBytes(bytes []byte, index int) {
-	o0 := index + 0
+func (reciever *AB) ToBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+20 {
+		return 0, packed.ErrShortBuffer
+	}
+	c58.ToBytesBigEndian(&reciever.Half, bytes, index+0)
+	c27.ToBytesLittleEndian(&reciever.Brain, bytes, index+2)
+	c62.ToBytesBigEndian(&reciever.Single, bytes, index+4)
+	c68.ToBytesBigEndian(&reciever.Double, bytes, index+8)
+	o16 := index + 16
 	for i0 := 0; i0 < 2; i0++ {
-		var b0 uint64
-		b0 |= (uint64(reciever.A[i0].A) & 0xF)
-		b0 |= (uint64(c0.Integer(&reciever.A[i0].B)) & 0x3FF) << 4
-		bytes[o0+0] = byte(b0 >> 0)
-		bytes[o0+1] = byte(b0 >> 8)
-		o0 += 2
+		c58.ToBytesBigEndian(&reciever.Weights[i0], bytes, o16)
+		o16 += 2
 	}
-	o4 := index + 4
+	return 20, nil
+}
+
+func (reciever *AB) FromBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+20 {
+		return 0, packed.ErrShortBuffer
+	}
+	c58.FromBytesBigEndian(&reciever.Half, bytes, index+0)
+	c27.FromBytesLittleEndian(&reciever.Brain, bytes, index+2)
+	c62.FromBytesBigEndian(&reciever.Single, bytes, index+4)
+	c68.FromBytesBigEndian(&reciever.Double, bytes, index+8)
+	o16 := index + 16
 	for i0 := 0; i0 < 2; i0++ {
-		var b0 uint64
-		b0 |= (uint64(reciever.B[i0].A) & 0x3F) << 10
-		b0 |= (uint64(reciever.B[i0].B.Integer()) & 0x3FF)
-		bytes[o4+1] = byte(b0 >> 0)
-		bytes[o4+0] = byte(b0 >> 8)
-		o4 += 2
+		c58.FromBytesBigEndian(&reciever.Weights[i0], bytes, o16)
+		o16 += 2
+	}
+	return 20, nil
+}
+
+// AF is 10 bytes, big endian, with 1 byte alignment.
+//
+//	offset  size  field
+//	0       3     Reading
+//	3       2     Offset
+//	5       1     Legacy
+//	6       2     Position
+//	8       2     Seconds (8 bits), Encoder (4 bits), Trim (4 bits)
+type AF struct {
+	Reading  uint64
+	Offset   int64
+	Legacy   int64
+	Position uint64
+	Seconds  uint64
+	Encoder  uint64
+	Trim     int64
+}
+
+func (reciever *AF) Size() int {
+	return 10
+}
+
+func (reciever *AF) ToBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+10 {
+		return 0, packed.ErrShortBuffer
+	}
+	if err := c6.ToBytesBigEndian(&reciever.Reading, bytes, index+0); err != nil {
+		return 0, &packed.FieldError{Path: "AF.Reading", Err: err}
+	}
+	if err := c34.ToBytesLittleEndian(&reciever.Offset, bytes, index+3); err != nil {
+		return 0, &packed.FieldError{Path: "AF.Offset", Err: err}
+	}
+	if err := c46.ToBytesBigEndian(&reciever.Legacy, bytes, index+5); err != nil {
+		return 0, &packed.FieldError{Path: "AF.Legacy", Err: err}
+	}
+	if err := c47.ToBytesBigEndian(&reciever.Position, bytes, index+6); err != nil {
+		return 0, &packed.FieldError{Path: "AF.Position", Err: err}
+	}
+	var b0 uint64
+	{
+		value, err := c35.Integer(&reciever.Seconds)
+		if err != nil {
+			return 0, &packed.FieldError{Path: "AF.Seconds", Err: err}
+		}
+		b0 |= (uint64(value) & 0xFF) << 8
+	}
+	{
+		value, err := c70.Integer(&reciever.Encoder)
+		if err != nil {
+			return 0, &packed.FieldError{Path: "AF.Encoder", Err: err}
+		}
+		b0 |= (uint64(value) & 0xF) << 4
+	}
+	{
+		value, err := c36.Integer(&reciever.Trim)
+		if err != nil {
+			return 0, &packed.FieldError{Path: "AF.Trim", Err: err}
+		}
+		b0 |= (uint64(value) & 0xF)
+	}
+	bytes[index+8+1] = byte(b0 >> 0)
+	bytes[index+8+0] = byte(b0 >> 8)
+	return 10, nil
+}
+
+func (reciever *AF) FromBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+10 {
+		return 0, packed.ErrShortBuffer
+	}
+	if err := c6.FromBytesBigEndian(&reciever.Reading, bytes, index+0); err != nil {
+		return 0, &packed.FieldError{Path: "AF.Reading", Err: err}
+	}
+	c34.FromBytesLittleEndian(&reciever.Offset, bytes, index+3)
+	c46.FromBytesBigEndian(&reciever.Legacy, bytes, index+5)
+	c47.FromBytesBigEndian(&reciever.Position, bytes, index+6)
+	var b0 uint64
+	b0 |= uint64(bytes[index+8+1]) << 0
+	b0 |= uint64(bytes[index+8+0]) << 8
+	if err := c35.Set(&reciever.Seconds, uint64(uint64((b0>>8)&0xFF))); err != nil {
+		return 0, &packed.FieldError{Path: "AF.Seconds", Err: err}
 	}
+	c70.Set(&reciever.Encoder, uint64(uint64((b0>>4)&0xF)))
+	c36.Set(&reciever.Trim, uint64(uint64((b0>>0)&0xF)))
+	return 10, nil
+}
+
+// AK is at least 28 bytes, big endian, with 1 byte alignment.
+//
+//	offset  size      field
+//	0       16        Key
+//	16      8         Hashes
+//	24      3         Offsets
+//	27      1         Count
+//	28      variable  Blob
+type AK struct {
+	Key     [16]byte
+	Hashes  [2][4]byte
+	Offsets [3]int8
+	Count   uint8
+	Blob    []uint8
+}
+
+func (reciever *AK) Size() int {
+	size := 28
+	size += len(reciever.Blob)
+	return size
 }
 
-func (reciever *M) FromBytes(bytes []byte, index int) {
+func (reciever *AK) ToBytes(bytes []byte, index int) (int, error) {
+	if uint64(len(reciever.Blob)) > 255 {
+		return 0, &packed.FieldError{Path: "AK.Blob", Err: packed.ErrInvalidLength}
+	}
+	reciever.Count = uint8(len(reciever.Blob))
+	if len(bytes) < index+reciever.Size() {
+		return 0, packed.ErrShortBuffer
+	}
+	start := index
 	o0 := index + 0
+	copy(bytes[o0:], reciever.Key[:])
+	o0 += 16
+	o16 := index + 16
 	for i0 := 0; i0 < 2; i0++ {
-		var b0 uint64
-		b0 |= uint64(bytes[o0+0]) << 0
-		b0 |= uint64(bytes[o0+1]) << 8
-		reciever.A[i0].A = uint8(uint64((b0 >> 0) & 0xF))
-		c0.Set(&reciever.A[i0].B, uint16(uint64((b0>>4)&0x3FF)))
-		o0 += 2
+		copy(bytes[o16:], reciever.Hashes[i0][:])
+		o16 += 4
 	}
-	o4 := index + 4
+	o24 := index + 24
+	for i0 := 0; i0 < 3; i0++ {
+		bytes[o24+i0] = byte(reciever.Offsets[i0])
+	}
+	o24 += 3
+	c49.ToBytesBigEndian(&reciever.Count, bytes, index+27)
+	index += 28
+	copy(bytes[index:], reciever.Blob[:])
+	index += len(reciever.Blob)
+	return index - start, nil
+}
+
+func (reciever *AK) FromBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+28 {
+		return 0, packed.ErrShortBuffer
+	}
+	start := index
+	o0 := index + 0
+	copy(reciever.Key[:], bytes[o0:])
+	o0 += 16
+	o16 := index + 16
 	for i0 := 0; i0 < 2; i0++ {
-		var b0 uint64
-		b0 |= uint64(bytes[o4+1]) << 0
-		b0 |= uint64(bytes[o4+0]) << 8
-		reciever.B[i0].A = uint8(uint64((b0 >> 10) & 0x3F))
-		reciever.B[i0].B.Set(uint16(uint64((b0 >> 0) & 0x3FF)))
-		o4 += 2
+		copy(reciever.Hashes[i0][:], bytes[o16:])
+		o16 += 4
 	}
+	o24 := index + 24
+	for i0 := 0; i0 < 3; i0++ {
+		reciever.Offsets[i0] = int8(bytes[o24+i0])
+	}
+	o24 += 3
+	c49.FromBytesBigEndian(&reciever.Count, bytes, index+27)
+	index += 28
+	if available := len(bytes) - index - 0; available < 0 || uint64(int(reciever.Count)) > uint64(available) {
+		return 0, &packed.FieldError{Path: "AK.Blob", Err: packed.ErrShortBuffer}
+	}
+	reciever.Blob = make([]uint8, int(reciever.Count))
+	copy(reciever.Blob[:], bytes[index:])
+	index += len(reciever.Blob)
+	return index - start, nil
 }
 
-type A struct {
-	A uint8  `json:"a" xml:"a"`
-	B uint16 `json:"b" xml:"b"`
-	C uint32 `json:"c" xml:"c"`
-	D int64  `json:"d" xml:"d"`
-	E int8   `json:"e" xml:"e"`
-	F int8   `json:"f" xml:"f"`
-	G types.ExampleTypeInterface
+// AM is 9 bytes, big endian, with 1 byte alignment.
+//
+//	offset  size  field
+//	0       2     Temperature
+//	2       3     Pressure
+//	5       4     Voltage (12 bits), Current (12 bits), Valid (1 bits), Mode (7 bits)
+type AM struct {
+	Temperature float64
+	Pressure    float64
+	Voltage     float64
+	Current     float64
+	Valid       bool
+	Mode        uint8
 }
 
-func (reciever *A) Size() int {
-	return 18
+func (reciever *AM) Size() int {
+	return 9
 }
 
-func (reciever *A) ToBytes(bytes []byte, index int) {
-	c3.ToBytesLittleEndian(&reciever.A, bytes, index+0)
-	c4.ToBytesLittleEndian(&reciever.B, bytes, index+1)
-	c1.ToBytesLittleEndian(&reciever.C, bytes, index+3)
-	c5.ToBytesLittleEndian(&reciever.D, bytes, index+7)
-	c2.ToBytesLittleEndian(&reciever.E, bytes, index+15)
-	c2.ToBytesLittleEndian(&reciever.F, bytes, index+16)
-	reciever.G.ToBytesLittleEndian(bytes, index+17)
+func (reciever *AM) ToBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+9 {
+		return 0, packed.ErrShortBuffer
+	}
+	c14.ToBytesBigEndian(&reciever.Temperature, bytes, index+0)
+	c72.ToBytesLittleEndian(&reciever.Pressure, bytes, index+2)
+	var b0 uint64
+	b0 |= (uint64(c48.Integer(&reciever.Voltage)) & 0xFFF) << 20
+	b0 |= (uint64(c76.Integer(&reciever.Current)) & 0xFFF) << 8
+	b0 |= (uint64(*(*uint8)(unsafe.Pointer(&reciever.Valid))) & 1) << 7
+	b0 |= (uint64(reciever.Mode) & 0x7F)
+	bytes[index+5+3] = byte(b0 >> 0)
+	bytes[index+5+2] = byte(b0 >> 8)
+	bytes[index+5+1] = byte(b0 >> 16)
+	bytes[index+5+0] = byte(b0 >> 24)
+	return 9, nil
 }
 
-func (reciever *A) FromBytes(bytes []byte, index int) {
-	c3.FromBytesLittleEndian(&reciever.A, bytes, index+0)
-	c4.FromBytesLittleEndian(&reciever.B, bytes, index+1)
-	c1.FromBytesLittleEndian(&reciever.C, bytes, index+3)
-	c5.FromBytesLittleEndian(&reciever.D, bytes, index+7)
-	c2.FromBytesLittleEndian(&reciever.E, bytes, index+15)
-	c2.FromBytesLittleEndian(&reciever.F, bytes, index+16)
-	reciever.G.FromBytesLittleEndian(bytes, index+17)
+func (reciever *AM) FromBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+9 {
+		return 0, packed.ErrShortBuffer
+	}
+	c14.FromBytesBigEndian(&reciever.Temperature, bytes, index+0)
+	c72.FromBytesLittleEndian(&reciever.Pressure, bytes, index+2)
+	var b0 uint64
+	b0 |= uint64(bytes[index+5+3]) << 0
+	b0 |= uint64(bytes[index+5+2]) << 8
+	b0 |= uint64(bytes[index+5+1]) << 16
+	b0 |= uint64(bytes[index+5+0]) << 24
+	c48.Set(&reciever.Voltage, uint16(uint64((b0>>20)&0xFFF)))
+	c76.Set(&reciever.Current, int16((((b0>>8)&0xFFF)^(1<<11))-(1<<11)))
+	reciever.Valid = ((b0 >> 7) & 0x1) != 0
+	reciever.Mode = uint8(uint64((b0 >> 0) & 0x7F))
+	return 9, nil
+}
+
+// AP is at least 2 bytes, big endian, with 1 byte alignment.
+//
+//	offset  size      field
+//	0       1         Kind
+//	1       1         Heading (2 bits), Count (6 bits)
+//	2       variable  Payload
+//	2+      variable  Items
+type AP struct {
+	Kind    Color
+	Heading Direction
+	Count   Color
+	Payload APPayload
+	Items   []uint8
+}
+
+type APPayload interface {
+	Size() int
+	ToBytes(bytes []byte, index int) (int, error)
+	FromBytes(bytes []byte, index int) (int, error)
+	isAPPayload()
+}
+
+func (*QA) isAPPayload() {}
+
+func (*QC) isAPPayload() {}
+
+func (reciever *AP) Size() int {
+	size := 2
+	if reciever.Payload != nil {
+		size += reciever.Payload.Size()
+	}
+	size += len(reciever.Items)
+	return size
+}
+
+func (reciever *AP) ToBytes(bytes []byte, index int) (int, error) {
+	switch reciever.Payload.(type) {
+	case *QA:
+		reciever.Kind = Color(1)
+	case *QC:
+		reciever.Kind = Color(4)
+	default:
+		return 0, &packed.FieldError{Path: "AP.Payload", Err: packed.ErrUnknownVariant}
+	}
+	if uint64(len(reciever.Items)) > 63 {
+		return 0, &packed.FieldError{Path: "AP.Items", Err: packed.ErrInvalidLength}
+	}
+	reciever.Count = Color(len(reciever.Items))
+	if len(bytes) < index+reciever.Size() {
+		return 0, packed.ErrShortBuffer
+	}
+	start := index
+	var r0 uint8
+	r0 = uint8(reciever.Kind)
+	c49.ToBytesBigEndian(&r0, bytes, index+0)
+	var b0 uint64
+	b0 |= (uint64(reciever.Heading) & 0x3) << 6
+	b0 |= (uint64(reciever.Count) & 0x3F)
+	bytes[index+1+0] = byte(b0 >> 0)
+	index += 2
+	if n, err := reciever.Payload.ToBytes(bytes, index+0); err != nil {
+		return 0, &packed.FieldError{Path: "AP.Payload", Err: err}
+	} else {
+		index += n
+	}
+	copy(bytes[index:], reciever.Items[:])
+	index += len(reciever.Items)
+	return index - start, nil
+}
+
+func (reciever *AP) FromBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+2 {
+		return 0, packed.ErrShortBuffer
+	}
+	start := index
+	var r0 uint8
+	c49.FromBytesBigEndian(&r0, bytes, index+0)
+	reciever.Kind = Color(r0)
+	var b0 uint64
+	b0 |= uint64(bytes[index+1+0]) << 0
+	reciever.Heading = Direction((((b0 >> 6) & 0x3) ^ (1 << 1)) - (1 << 1))
+	reciever.Count = Color(uint64((b0 >> 0) & 0x3F))
+	index += 2
+	switch reciever.Kind {
+	case 1:
+		reciever.Payload = new(QA)
+	case 4:
+		reciever.Payload = new(QC)
+	default:
+		return 0, &packed.FieldError{Path: "AP.Payload", Err: packed.ErrUnknownVariant}
+	}
+	if n, err := reciever.Payload.FromBytes(bytes, index+0); err != nil {
+		return 0, &packed.FieldError{Path: "AP.Payload", Err: err}
+	} else {
+		index += n
+	}
+	if len(bytes)-index < 0 {
+		return 0, &packed.FieldError{Path: "AP.Payload", Err: packed.ErrShortBuffer}
+	}
+	if available := len(bytes) - index - 0; available < 0 || uint64(int(reciever.Count)) > uint64(available) {
+		return 0, &packed.FieldError{Path: "AP.Items", Err: packed.ErrShortBuffer}
+	}
+	reciever.Items = make([]uint8, int(reciever.Count))
+	copy(reciever.Items[:], bytes[index:])
+	index += len(reciever.Items)
+	return index - start, nil
+}
+
+// C is 9 bytes, little endian, with 1 byte alignment.
+//
+//	offset  size  field
+//	0       8     A (4 bits), B (10 bits), C (20 bits), D (30 bits)
+//	8       1     E (4 bits), F (1 bits), G (3 bits)
+type C struct {
+	A uint8
+	B uint16
+	C uint32
+	D int64
+	E int8
+	F bool
+	G int8
+}
+
+func (reciever *C) Size() int {
+	return 9
+}
+
+func (reciever *C) ToBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+9 {
+		return 0, packed.ErrShortBuffer
+	}
+	var b0 uint64
+	b0 |= (uint64(reciever.A) & 0xF)
+	b0 |= (uint64(reciever.B) & 0x3FF) << 4
+	b0 |= (uint64(reciever.C) & 0xFFFFF) << 14
+	b0 |= (uint64(reciever.D) & 0x3FFFFFFF) << 34
+	bytes[index+0+0] = byte(b0 >> 0)
+	bytes[index+0+1] = byte(b0 >> 8)
+	bytes[index+0+2] = byte(b0 >> 16)
+	bytes[index+0+3] = byte(b0 >> 24)
+	bytes[index+0+4] = byte(b0 >> 32)
+	bytes[index+0+5] = byte(b0 >> 40)
+	bytes[index+0+6] = byte(b0 >> 48)
+	bytes[index+0+7] = byte(b0 >> 56)
+	var b1 uint64
+	b1 |= (uint64(reciever.E) & 0xF)
+	b1 |= (uint64(*(*uint8)(unsafe.Pointer(&reciever.F))) & 1) << 4
+	b1 |= (uint64(reciever.G) & 0x7) << 5
+	bytes[index+8+0] = byte(b1 >> 0)
+	return 9, nil
+}
+
+func (reciever *C) FromBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+9 {
+		return 0, packed.ErrShortBuffer
+	}
+	var b0 uint64
+	b0 |= uint64(bytes[index+0+0]) << 0
+	b0 |= uint64(bytes[index+0+1]) << 8
+	b0 |= uint64(bytes[index+0+2]) << 16
+	b0 |= uint64(bytes[index+0+3]) << 24
+	b0 |= uint64(bytes[index+0+4]) << 32
+	b0 |= uint64(bytes[index+0+5]) << 40
+	b0 |= uint64(bytes[index+0+6]) << 48
+	b0 |= uint64(bytes[index+0+7]) << 56
+	reciever.A = uint8(uint64((b0 >> 0) & 0xF))
+	reciever.B = uint16(uint64((b0 >> 4) & 0x3FF))
+	reciever.C = uint32(uint64((b0 >> 14) & 0xFFFFF))
+	reciever.D = int64((((b0 >> 34) & 0x3FFFFFFF) ^ (1 << 29)) - (1 << 29))
+	var b1 uint64
+	b1 |= uint64(bytes[index+8+0]) << 0
+	reciever.E = int8((((b1 >> 0) & 0xF) ^ (1 << 3)) - (1 << 3))
+	reciever.F = ((b1 >> 4) & 0x1) != 0
+	reciever.G = int8((((b1 >> 5) & 0x7) ^ (1 << 2)) - (1 << 2))
+	return 9, nil
+}
+
+// F is 8 bytes, little endian, with 1 byte alignment.
+//
+//	offset  size  field
+//	0       8     A
+type F struct {
+	A [2][2][2]types.ExampleTypeInterface
+}
+
+func (reciever *F) Size() int {
+	return 8
+}
+
+func (reciever *F) ToBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+8 {
+		return 0, packed.ErrShortBuffer
+	}
+	o0 := index + 0
+	for i0 := 0; i0 < 2; i0++ {
+		for i1 := 0; i1 < 2; i1++ {
+			for i2 := 0; i2 < 2; i2++ {
+				reciever.A[i0][i1][i2].ToBytesLittleEndian(bytes, o0)
+				o0 += 1
+			}
+		}
+	}
+	return 8, nil
+}
+
+func (reciever *F) FromBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+8 {
+		return 0, packed.ErrShortBuffer
+	}
+	o0 := index + 0
+	for i0 := 0; i0 < 2; i0++ {
+		for i1 := 0; i1 < 2; i1++ {
+			for i2 := 0; i2 < 2; i2++ {
+				reciever.A[i0][i1][i2].FromBytesLittleEndian(bytes, o0)
+				o0 += 1
+			}
+		}
+	}
+	return 8, nil
+}
+
+// H is 2 bytes, big endian, with 1 byte alignment.
+//
+//	offset  size  field
+//	0       2     A
+type H struct {
+	A types.ExampleEnum
+}
+
+func (reciever *H) Size() int {
+	return 2
+}
+
+func (reciever *H) ToBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+2 {
+		return 0, packed.ErrShortBuffer
+	}
+	var r0 int16
+	r0 = int16(reciever.A)
+	c18.ToBytesBigEndian(&r0, bytes, index+0)
+	return 2, nil
+}
+
+func (reciever *H) FromBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+2 {
+		return 0, packed.ErrShortBuffer
+	}
+	var r0 int16
+	c18.FromBytesBigEndian(&r0, bytes, index+0)
+	reciever.A = types.ExampleEnum(r0)
+	return 2, nil
+}
+
+// QA is 3 bytes, little endian, with 1 byte alignment.
+//
+//	offset  size  field
+//	0       2     A
+//	2       1     B
+type QA struct {
+	A uint16
+	B int8
+}
+
+func (reciever *QA) Size() int {
+	return 3
+}
+
+func (reciever *QA) ToBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+3 {
+		return 0, packed.ErrShortBuffer
+	}
+	c30.ToBytesLittleEndian(&reciever.A, bytes, index+0)
+	c38.ToBytesLittleEndian(&reciever.B, bytes, index+2)
+	return 3, nil
+}
+
+func (reciever *QA) FromBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+3 {
+		return 0, packed.ErrShortBuffer
+	}
+	c30.FromBytesLittleEndian(&reciever.A, bytes, index+0)
+	c38.FromBytesLittleEndian(&reciever.B, bytes, index+2)
+	return 3, nil
+}
+
+// U is 20 bytes, big endian, with 1 byte alignment.
+//
+//	offset  size  field
+//	0       4     Magic (const 0xcafebabe)
+//	4       4     Tag (const "RIFF")
+//	8       2     Raw (const [2]uint8{0xde, 0xad})
+//	10      2     Kind (const 3)
+//	12      2     A
+//	14      6     B
+type U struct {
+	A uint16
+	B [2]UA
+}
+
+func (reciever *U) Magic() uint32 {
+	return 0xcafebabe
+}
+
+func (reciever *U) Tag() string {
+	return "RIFF"
+}
+
+func (reciever *U) Raw() [2]uint8 {
+	return [2]uint8{0xde, 0xad}
+}
+
+func (reciever *U) Kind() types.ExampleEnum {
+	return 3
+}
+
+func (reciever *U) Size() int {
+	return 20
+}
+
+func (reciever *U) ToBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+20 {
+		return 0, packed.ErrShortBuffer
+	}
+	copy(bytes[index+0:], "\xca\xfe\xba\xbe")
+	copy(bytes[index+4:], "RIFF")
+	copy(bytes[index+8:], "\xde\xad")
+	copy(bytes[index+10:], "\x00\x03")
+	c30.ToBytesBigEndian(&reciever.A, bytes, index+12)
+	o14 := index + 14
+	for i0 := 0; i0 < 2; i0++ {
+		copy(bytes[o14:], "\xef\xbe")
+		o14 += 2
+		c49.ToBytesLittleEndian(&reciever.B[i0].A, bytes, o14)
+		o14 += 1
+	}
+	return 20, nil
+}
+
+func (reciever *U) FromBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+20 {
+		return 0, packed.ErrShortBuffer
+	}
+	if string(bytes[index+0:index+0+4]) != "\xca\xfe\xba\xbe" {
+		return 0, &packed.FieldError{Path: "U.Magic", Err: fmt.Errorf("%w: expected % x, got % x", packed.ErrConstMismatch, "\xca\xfe\xba\xbe", bytes[index+0:index+0+4])}
+	}
+	if string(bytes[index+4:index+4+4]) != "RIFF" {
+		return 0, &packed.FieldError{Path: "U.Tag", Err: fmt.Errorf("%w: expected % x, got % x", packed.ErrConstMismatch, "RIFF", bytes[index+4:index+4+4])}
+	}
+	if string(bytes[index+8:index+8+2]) != "\xde\xad" {
+		return 0, &packed.FieldError{Path: "U.Raw", Err: fmt.Errorf("%w: expected % x, got % x", packed.ErrConstMismatch, "\xde\xad", bytes[index+8:index+8+2])}
+	}
+	if string(bytes[index+10:index+10+2]) != "\x00\x03" {
+		return 0, &packed.FieldError{Path: "U.Kind", Err: fmt.Errorf("%w: expected % x, got % x", packed.ErrConstMismatch, "\x00\x03", bytes[index+10:index+10+2])}
+	}
+	c30.FromBytesBigEndian(&reciever.A, bytes, index+12)
+	o14 := index + 14
+	for i0 := 0; i0 < 2; i0++ {
+		if string(bytes[o14:o14+2]) != "\xef\xbe" {
+			return 0, &packed.FieldError{Path: "U.B.Marker", Err: fmt.Errorf("%w: expected % x, got % x", packed.ErrConstMismatch, "\xef\xbe", bytes[o14:o14+2])}
+		}
+		o14 += 2
+		c49.FromBytesLittleEndian(&reciever.B[i0].A, bytes, o14)
+		o14 += 1
+	}
+	return 20, nil
+}
+
+// VA is 4 bytes, big endian, with 1 byte alignment.
+//
+//	offset  size  field
+//	0       2     Length
+//	2       1     Kind
+//	3       1     CRC (checksum of start..here)
+type VA struct {
+	Length uint16
+	Kind   uint8
+	CRC    uint8
+}
+
+func (reciever *VA) Size() int {
+	return 4
+}
+
+func (reciever *VA) ToBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+4 {
+		return 0, packed.ErrShortBuffer
+	}
+	checksumStartVACRC := index + 0
+	c30.ToBytesBigEndian(&reciever.Length, bytes, index+0)
+	c49.ToBytesBigEndian(&reciever.Kind, bytes, index+2)
+	checksumEndVACRC := index + 3
+	checksumIndexVACRC := index + 3
+	reciever.CRC = uint8(c55.Checksum(bytes[checksumStartVACRC:checksumEndVACRC]))
+	bytes[checksumIndexVACRC+0] = byte(reciever.CRC)
+	return 4, nil
+}
+
+func (reciever *VA) FromBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+4 {
+		return 0, packed.ErrShortBuffer
+	}
+	checksumStartVACRC := index + 0
+	c30.FromBytesBigEndian(&reciever.Length, bytes, index+0)
+	c49.FromBytesBigEndian(&reciever.Kind, bytes, index+2)
+	checksumEndVACRC := index + 3
+	reciever.CRC = uint8(bytes[index+3+0])
+	if checksum := uint8(c55.Checksum(bytes[checksumStartVACRC:checksumEndVACRC])); checksum != reciever.CRC {
+		return 0, &packed.FieldError{Path: "VA.CRC", Err: fmt.Errorf("%w: expected 0x%X, got 0x%X", packed.ErrChecksumMismatch, checksum, reciever.CRC)}
+	}
+	return 4, nil
+}
+
+// AI is 38 bytes, big endian, with 1 byte alignment.
+//
+//	offset  size  field
+//	0       6     Source
+//	6       6     Destination
+//	12      4     Gateway
+//	16      6     Peer
+//	22      16    Link
+type AI struct {
+	Source      packed.MAC
+	Destination net.HardwareAddr
+	Gateway     netip.Addr
+	Peer        netip.AddrPort
+	Link        netip.Addr
+}
+
+func (reciever *AI) Size() int {
+	return 38
+}
+
+func (reciever *AI) ToBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+38 {
+		return 0, packed.ErrShortBuffer
+	}
+	c71.ToBytesBigEndian(&reciever.Source, bytes, index+0)
+	if err := c52.ToBytesBigEndian(&reciever.Destination, bytes, index+6); err != nil {
+		return 0, &packed.FieldError{Path: "AI.Destination", Err: err}
+	}
+	if err := c5.ToBytesBigEndian(&reciever.Gateway, bytes, index+12); err != nil {
+		return 0, &packed.FieldError{Path: "AI.Gateway", Err: err}
+	}
+	if err := c2.ToBytesBigEndian(&reciever.Peer, bytes, index+16); err != nil {
+		return 0, &packed.FieldError{Path: "AI.Peer", Err: err}
+	}
+	if err := c21.ToBytesBigEndian(&reciever.Link, bytes, index+22); err != nil {
+		return 0, &packed.FieldError{Path: "AI.Link", Err: err}
+	}
+	return 38, nil
+}
+
+func (reciever *AI) FromBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+38 {
+		return 0, packed.ErrShortBuffer
+	}
+	c71.FromBytesBigEndian(&reciever.Source, bytes, index+0)
+	c52.FromBytesBigEndian(&reciever.Destination, bytes, index+6)
+	c5.FromBytesBigEndian(&reciever.Gateway, bytes, index+12)
+	c2.FromBytesBigEndian(&reciever.Peer, bytes, index+16)
+	if err := c21.FromBytesBigEndian(&reciever.Link, bytes, index+22); err != nil {
+		return 0, &packed.FieldError{Path: "AI.Link", Err: err}
+	}
+	return 38, nil
+}
+
+// AJ is 26 bytes, big endian, with 1 byte alignment.
+//
+//	offset  size  field
+//	0       8     Vendor
+//	8       6     Label
+//	14      4     Owner
+//	18      8     Title
+type AJ struct {
+	Vendor string
+	Label  string
+	Owner  string
+	Title  string
+}
+
+func (reciever *AJ) Size() int {
+	return 26
+}
+
+func (reciever *AJ) ToBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+26 {
+		return 0, packed.ErrShortBuffer
+	}
+	if err := c22.ToBytesBigEndian(&reciever.Vendor, bytes, index+0); err != nil {
+		return 0, &packed.FieldError{Path: "AJ.Vendor", Err: err}
+	}
+	if err := c13.ToBytesBigEndian(&reciever.Label, bytes, index+8); err != nil {
+		return 0, &packed.FieldError{Path: "AJ.Label", Err: err}
+	}
+	if err := c60.ToBytesBigEndian(&reciever.Owner, bytes, index+14); err != nil {
+		return 0, &packed.FieldError{Path: "AJ.Owner", Err: err}
+	}
+	if err := c28.ToBytesBigEndian(&reciever.Title, bytes, index+18); err != nil {
+		return 0, &packed.FieldError{Path: "AJ.Title", Err: err}
+	}
+	return 26, nil
+}
+
+func (reciever *AJ) FromBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+26 {
+		return 0, packed.ErrShortBuffer
+	}
+	if err := c22.FromBytesBigEndian(&reciever.Vendor, bytes, index+0); err != nil {
+		return 0, &packed.FieldError{Path: "AJ.Vendor", Err: err}
+	}
+	if err := c13.FromBytesBigEndian(&reciever.Label, bytes, index+8); err != nil {
+		return 0, &packed.FieldError{Path: "AJ.Label", Err: err}
+	}
+	if err := c60.FromBytesBigEndian(&reciever.Owner, bytes, index+14); err != nil {
+		return 0, &packed.FieldError{Path: "AJ.Owner", Err: err}
+	}
+	if err := c28.FromBytesBigEndian(&reciever.Title, bytes, index+18); err != nil {
+		return 0, &packed.FieldError{Path: "AJ.Title", Err: err}
+	}
+	return 26, nil
+}
+
+// UA is 3 bytes, little endian, with 1 byte alignment.
+//
+//	offset  size  field
+//	0       2     Marker (const 0xbeef)
+//	2       1     A
+type UA struct {
+	A uint8
+}
+
+func (reciever *UA) Marker() uint16 {
+	return 0xbeef
+}
+
+func (reciever *UA) Size() int {
+	return 3
+}
+
+func (reciever *UA) ToBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+3 {
+		return 0, packed.ErrShortBuffer
+	}
+	copy(bytes[index+0:], "\xef\xbe")
+	c49.ToBytesLittleEndian(&reciever.A, bytes, index+2)
+	return 3, nil
+}
+
+func (reciever *UA) FromBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+3 {
+		return 0, packed.ErrShortBuffer
+	}
+	if string(bytes[index+0:index+0+2]) != "\xef\xbe" {
+		return 0, &packed.FieldError{Path: "UA.Marker", Err: fmt.Errorf("%w: expected % x, got % x", packed.ErrConstMismatch, "\xef\xbe", bytes[index+0:index+0+2])}
+	}
+	c49.FromBytesLittleEndian(&reciever.A, bytes, index+2)
+	return 3, nil
+}
+
+// G is 8 bytes, little endian, with 1 byte alignment.
+//
+//	offset  size  field
+//	0       8     A
+type G struct {
+	A [2][2][2]types.ExampleRecieverType
+}
+
+func (reciever *G) Size() int {
+	return 8
+}
+
+func (reciever *G) ToBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+8 {
+		return 0, packed.ErrShortBuffer
+	}
+	o0 := index + 0
+	for i0 := 0; i0 < 2; i0++ {
+		for i1 := 0; i1 < 2; i1++ {
+			for i2 := 0; i2 < 2; i2++ {
+				c66.ToBytesLittleEndian(&reciever.A[i0][i1][i2], bytes, o0)
+				o0 += 1
+			}
+		}
+	}
+	return 8, nil
+}
+
+func (reciever *G) FromBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+8 {
+		return 0, packed.ErrShortBuffer
+	}
+	o0 := index + 0
+	for i0 := 0; i0 < 2; i0++ {
+		for i1 := 0; i1 < 2; i1++ {
+			for i2 := 0; i2 < 2; i2++ {
+				c66.FromBytesLittleEndian(&reciever.A[i0][i1][i2], bytes, o0)
+				o0 += 1
+			}
+		}
+	}
+	return 8, nil
+}
+
+// L is 2 bytes, little endian, with 1 byte alignment.
+//
+//	offset  size  field
+//	0       2     A (4 bits), B (10 bits)
+type L struct {
+	A uint8
+	B [10]bool
+}
+
+func (reciever *L) Size() int {
+	return 2
+}
+
+func (reciever *L) ToBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+2 {
+		return 0, packed.ErrShortBuffer
+	}
+	var b0 uint64
+	b0 |= (uint64(reciever.A) & 0xF)
+	b0 |= (uint64(c31.Integer(&reciever.B)) & 0x3FF) << 4
+	bytes[index+0+0] = byte(b0 >> 0)
+	bytes[index+0+1] = byte(b0 >> 8)
+	return 2, nil
+}
+
+func (reciever *L) FromBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+2 {
+		return 0, packed.ErrShortBuffer
+	}
+	var b0 uint64
+	b0 |= uint64(bytes[index+0+0]) << 0
+	b0 |= uint64(bytes[index+0+1]) << 8
+	reciever.A = uint8(uint64((b0 >> 0) & 0xF))
+	c31.Set(&reciever.B, uint16(uint64((b0>>4)&0x3FF)))
+	return 2, nil
+}
+
+// QB is at least 1 bytes, little endian, with 1 byte alignment.
+//
+//	offset  size      field
+//	0       1         Length
+//	1       variable  Text
+type QB struct {
+	Length uint8
+	Text   string
+}
+
+func (reciever *QB) Size() int {
+	size := 1
+	size += len(reciever.Text)
+	return size
+}
+
+func (reciever *QB) ToBytes(bytes []byte, index int) (int, error) {
+	if uint64(len(reciever.Text)) > 255 {
+		return 0, &packed.FieldError{Path: "QB.Text", Err: packed.ErrInvalidLength}
+	}
+	reciever.Length = uint8(len(reciever.Text))
+	if len(bytes) < index+reciever.Size() {
+		return 0, packed.ErrShortBuffer
+	}
+	start := index
+	c49.ToBytesLittleEndian(&reciever.Length, bytes, index+0)
+	index += 1
+	copy(bytes[index:], reciever.Text)
+	index += len(reciever.Text)
+	return index - start, nil
+}
+
+func (reciever *QB) FromBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+1 {
+		return 0, packed.ErrShortBuffer
+	}
+	start := index
+	c49.FromBytesLittleEndian(&reciever.Length, bytes, index+0)
+	index += 1
+	if available := len(bytes) - index - 0; available < 0 || uint64(int(reciever.Length)) > uint64(available) {
+		return 0, &packed.FieldError{Path: "QB.Text", Err: packed.ErrShortBuffer}
+	}
+	reciever.Text = string(bytes[index : index+int(reciever.Length)])
+	index += len(reciever.Text)
+	return index - start, nil
+}
+
+// QC is 4 bytes, little endian, with 1 byte alignment.
+//
+//	offset  size  field
+//	0       4     A
+type QC struct {
+	A uint32
+}
+
+func (reciever *QC) Size() int {
+	return 4
+}
+
+func (reciever *QC) ToBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+4 {
+		return 0, packed.ErrShortBuffer
+	}
+	c43.ToBytesLittleEndian(&reciever.A, bytes, index+0)
+	return 4, nil
+}
+
+func (reciever *QC) FromBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+4 {
+		return 0, packed.ErrShortBuffer
+	}
+	c43.FromBytesLittleEndian(&reciever.A, bytes, index+0)
+	return 4, nil
+}
+
+// AL is 28 bytes, big endian, with 1 byte alignment.
+//
+//	offset  size  field
+//	0       8     Name
+//	8       8     Tags
+//	16      8     Comment
+//	24      4     Sequence
+type AL struct {
+	Name     RecordName
+	Tags     [2]RecordTag
+	Comment  string
+	Sequence uint32
+}
+
+func (reciever *AL) Size() int {
+	return 28
+}
+
+func (reciever *AL) ToBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+28 {
+		return 0, packed.ErrShortBuffer
+	}
+	o0 := index + 0
+	copy(bytes[o0:], reciever.Name[:])
+	o0 += 8
+	o8 := index + 8
+	for i0 := 0; i0 < 2; i0++ {
+		copy(bytes[o8:], reciever.Tags[i0][:])
+		o8 += 4
+	}
+	if err := c75.ToBytesBigEndian(&reciever.Comment, bytes, index+16); err != nil {
+		return 0, &packed.FieldError{Path: "AL.Comment", Err: err}
+	}
+	c43.ToBytesBigEndian(&reciever.Sequence, bytes, index+24)
+	return 28, nil
+}
+
+func (reciever *AL) FromBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+28 {
+		return 0, packed.ErrShortBuffer
+	}
+	o0 := index + 0
+	copy(reciever.Name[:], bytes[o0:])
+	o0 += 8
+	if err := c22.Verify(reciever.Name[:], 0); err != nil {
+		return 0, &packed.FieldError{Path: "AL.Name", Err: err}
+	}
+	o8 := index + 8
+	for i0 := 0; i0 < 2; i0++ {
+		copy(reciever.Tags[i0][:], bytes[o8:])
+		o8 += 4
+		if err := c3.Verify(reciever.Tags[i0][:], 0); err != nil {
+			return 0, &packed.FieldError{Path: "AL.Tags", Err: err}
+		}
+	}
+	if err := c75.FromBytesBigEndian(&reciever.Comment, bytes, index+16); err != nil {
+		return 0, &packed.FieldError{Path: "AL.Comment", Err: err}
+	}
+	c43.FromBytesBigEndian(&reciever.Sequence, bytes, index+24)
+	return 28, nil
+}
+
+// B is 9 bytes, big endian, with 1 byte alignment.
+//
+//	offset  size  field
+//	0       8     A (4 bits), B (10 bits), C (20 bits), D (30 bits)
+//	8       1     E (4 bits), F (1 bits), G (3 bits)
+type B struct {
+	A uint8  `json:"a" xml:"a"`
+	B uint16 `json:"b" xml:"b"`
+	C uint32 `json:"c" xml:"c"`
+	D int64  `json:"d" xml:"d"`
+	E int8   `json:"e" xml:"e"`
+	F bool   `json:"f" xml:"f"`
+	G int8   `json:"g" xml:"g"`
+}
+
+func (reciever *B) Size() int {
+	return 9
+}
+
+func (reciever *B) ToBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+9 {
+		return 0, packed.ErrShortBuffer
+	}
+	var b0 uint64
+	b0 |= (uint64(reciever.A) & 0xF) << 60
+	b0 |= (uint64(reciever.B) & 0x3FF) << 50
+	b0 |= (uint64(reciever.C) & 0xFFFFF) << 30
+	b0 |= (uint64(reciever.D) & 0x3FFFFFFF)
+	bytes[index+0+7] = byte(b0 >> 0)
+	bytes[index+0+6] = byte(b0 >> 8)
+	bytes[index+0+5] = byte(b0 >> 16)
+	bytes[index+0+4] = byte(b0 >> 24)
+	bytes[index+0+3] = byte(b0 >> 32)
+	bytes[index+0+2] = byte(b0 >> 40)
+	bytes[index+0+1] = byte(b0 >> 48)
+	bytes[index+0+0] = byte(b0 >> 56)
+	var b1 uint64
+	b1 |= (uint64(reciever.E) & 0xF) << 4
+	b1 |= (uint64(*(*uint8)(unsafe.Pointer(&reciever.F))) & 1) << 3
+	b1 |= (uint64(reciever.G) & 0x7)
+	bytes[index+8+0] = byte(b1 >> 0)
+	return 9, nil
+}
+
+func (reciever *B) FromBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+9 {
+		return 0, packed.ErrShortBuffer
+	}
+	var b0 uint64
+	b0 |= uint64(bytes[index+0+7]) << 0
+	b0 |= uint64(bytes[index+0+6]) << 8
+	b0 |= uint64(bytes[index+0+5]) << 16
+	b0 |= uint64(bytes[index+0+4]) << 24
+	b0 |= uint64(bytes[index+0+3]) << 32
+	b0 |= uint64(bytes[index+0+2]) << 40
+	b0 |= uint64(bytes[index+0+1]) << 48
+	b0 |= uint64(bytes[index+0+0]) << 56
+	reciever.A = uint8(uint64((b0 >> 60) & 0xF))
+	reciever.B = uint16(uint64((b0 >> 50) & 0x3FF))
+	reciever.C = uint32(uint64((b0 >> 30) & 0xFFFFF))
+	reciever.D = int64((((b0 >> 0) & 0x3FFFFFFF) ^ (1 << 29)) - (1 << 29))
+	var b1 uint64
+	b1 |= uint64(bytes[index+8+0]) << 0
+	reciever.E = int8((((b1 >> 4) & 0xF) ^ (1 << 3)) - (1 << 3))
+	reciever.F = ((b1 >> 3) & 0x1) != 0
+	reciever.G = int8((((b1 >> 0) & 0x7) ^ (1 << 2)) - (1 << 2))
+	return 9, nil
+}
+
+// RA is 2 bytes, little endian, with 1 byte alignment.
+//
+//	offset  size  field
+//	0       1     A
+//	1       1     (padding)
+type RA struct {
+	A uint8
+}
+
+func (reciever *RA) Size() int {
+	return 2
+}
+
+func (reciever *RA) ToBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+2 {
+		return 0, packed.ErrShortBuffer
+	}
+	c49.ToBytesLittleEndian(&reciever.A, bytes, index+0)
+	for i := index + 1; i < index+1+1; i++ {
+		bytes[i] = 0xAA
+	}
+	return 2, nil
+}
+
+func (reciever *RA) FromBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+2 {
+		return 0, packed.ErrShortBuffer
+	}
+	c49.FromBytesLittleEndian(&reciever.A, bytes, index+0)
+	for i := index + 1; i < index+1+1; i++ {
+		if bytes[i] != 0xAA {
+			return 0, &packed.FieldError{Path: "RA", Err: packed.ErrInvalidPadding}
+		}
+	}
+	return 2, nil
+}
+
+// T is 16 bytes, big endian, with 2 byte alignment.
+//
+//	offset  size  field
+//	0       1     A
+//	1       1     (padding)
+//	2       8     B
+//	10      4     C
+//	14      1     D
+//	15      1     (padding)
+type T struct {
+	A uint8
+	B int64
+	C SA
+	D uint8
+}
+
+func (reciever *T) Size() int {
+	return 16
+}
+
+func (reciever *T) ToBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+16 {
+		return 0, packed.ErrShortBuffer
+	}
+	c49.ToBytesBigEndian(&reciever.A, bytes, index+0)
+	clear(bytes[index+1 : index+1+1])
+	c44.ToBytesBigEndian(&reciever.B, bytes, index+2)
+	c49.ToBytesBigEndian(&reciever.C.A, bytes, index+10)
+	clear(bytes[index+11 : index+11+1])
+	c30.ToBytesBigEndian(&reciever.C.B, bytes, index+12)
+	c49.ToBytesBigEndian(&reciever.D, bytes, index+14)
+	clear(bytes[index+15 : index+15+1])
+	return 16, nil
+}
+
+func (reciever *T) FromBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+16 {
+		return 0, packed.ErrShortBuffer
+	}
+	c49.FromBytesBigEndian(&reciever.A, bytes, index+0)
+	c44.FromBytesBigEndian(&reciever.B, bytes, index+2)
+	c49.FromBytesBigEndian(&reciever.C.A, bytes, index+10)
+	c30.FromBytesBigEndian(&reciever.C.B, bytes, index+12)
+	c49.FromBytesBigEndian(&reciever.D, bytes, index+14)
+	return 16, nil
+}
+
+// X is 26 bytes, big endian, with 1 byte alignment.
+//
+//	offset  size  field
+//	0       1     Kind
+//	1       4     Name
+//	5       4     Level
+//	9       1     Mode
+//	10      8     Matrix
+//	18      6     B
+//	24      2     Nested
+type X struct {
+	Kind   types.ExampleEnum
+	Name   string
+	Level  float32
+	Mode   types.ExampleEnumString
+	Matrix [2][2]uint16
+	B      [3]XA
+	Nested XA
+}
+
+func (reciever *X) Size() int {
+	return 26
+}
+
+func (reciever *X) ToBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+26 {
+		return 0, packed.ErrShortBuffer
+	}
+	var r1 string
+	var r0 int8
+	r0 = int8(reciever.Kind)
+	c38.ToBytesBigEndian(&r0, bytes, index+0)
+	c67.ToBytesBigEndian(&reciever.Name, bytes, index+1)
+	c56.ToBytesBigEndian(&reciever.Level, bytes, index+5)
+	r1 = string(reciever.Mode)
+	c45.ToBytesBigEndian(&r1, bytes, index+9)
+	o10 := index + 10
+	for i0 := 0; i0 < 2; i0++ {
+		for i1 := 0; i1 < 2; i1++ {
+			c30.ToBytesBigEndian(&reciever.Matrix[i0][i1], bytes, o10)
+			o10 += 2
+		}
+	}
+	o18 := index + 18
+	for i0 := 0; i0 < 3; i0++ {
+		c49.ToBytesLittleEndian(&reciever.B[i0].A, bytes, o18)
+		o18 += 1
+		var b0 uint64
+		b0 |= (uint64(reciever.B[i0].B) & 0x7)
+		b0 |= (uint64(reciever.B[i0].C) & 0x1F) << 3
+		bytes[o18+0] = byte(b0 >> 0)
+		o18 += 1
+	}
+	c49.ToBytesBigEndian(&reciever.Nested.A, bytes, index+24)
+	var b0 uint64
+	b0 |= (uint64(reciever.Nested.B) & 0x7) << 5
+	b0 |= (uint64(reciever.Nested.C) & 0x1F)
+	bytes[index+25+0] = byte(b0 >> 0)
+	return 26, nil
+}
+
+func (reciever *X) FromBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+26 {
+		return 0, packed.ErrShortBuffer
+	}
+	var r0 int8
+	var r1 string
+	c38.FromBytesBigEndian(&r0, bytes, index+0)
+	reciever.Kind = types.ExampleEnum(r0)
+	c67.FromBytesBigEndian(&reciever.Name, bytes, index+1)
+	c56.FromBytesBigEndian(&reciever.Level, bytes, index+5)
+	c45.FromBytesBigEndian(&r1, bytes, index+9)
+	reciever.Mode = types.ExampleEnumString(r1)
+	o10 := index + 10
+	for i0 := 0; i0 < 2; i0++ {
+		for i1 := 0; i1 < 2; i1++ {
+			c30.FromBytesBigEndian(&reciever.Matrix[i0][i1], bytes, o10)
+			o10 += 2
+		}
+	}
+	o18 := index + 18
+	for i0 := 0; i0 < 3; i0++ {
+		c49.FromBytesLittleEndian(&reciever.B[i0].A, bytes, o18)
+		o18 += 1
+		var b0 uint64
+		b0 |= uint64(bytes[o18+0]) << 0
+		reciever.B[i0].B = uint8(uint64((b0 >> 0) & 0x7))
+		reciever.B[i0].C = uint8(uint64((b0 >> 3) & 0x1F))
+		o18 += 1
+	}
+	c49.FromBytesBigEndian(&reciever.Nested.A, bytes, index+24)
+	var b0 uint64
+	b0 |= uint64(bytes[index+25+0]) << 0
+	reciever.Nested.B = uint8(uint64((b0 >> 5) & 0x7))
+	reciever.Nested.C = uint8(uint64((b0 >> 0) & 0x1F))
+	return 26, nil
+}
+
+func (reciever *X) Validate() error {
+	if !reciever.Kind.IsValid() {
+		return &packed.FieldError{Path: "X.Kind", Err: fmt.Errorf("%w: %v is not a valid value", packed.ErrInvalidValue, reciever.Kind)}
+	}
+	if reciever.Name == "" {
+		return &packed.FieldError{Path: "X.Name", Err: fmt.Errorf("%w: value is zero", packed.ErrInvalidValue)}
+	}
+	if reciever.Level < -1.5 || reciever.Level > 1.5 {
+		return &packed.FieldError{Path: "X.Level", Err: fmt.Errorf("%w: %v is not between %v and %v", packed.ErrInvalidValue, reciever.Level, -1.5, 1.5)}
+	}
+	switch reciever.Mode {
+	case "A", "B":
+	default:
+		return &packed.FieldError{Path: "X.Mode", Err: fmt.Errorf("%w: %v is not one of \"A\", \"B\"", packed.ErrInvalidValue, reciever.Mode)}
+	}
+	for i0 := range reciever.Matrix {
+		for i1 := range reciever.Matrix[i0] {
+			if reciever.Matrix[i0][i1] < 0 || reciever.Matrix[i0][i1] > 1000 {
+				return &packed.FieldError{Path: fmt.Sprintf("X.Matrix[%d][%d]", i0, i1), Err: fmt.Errorf("%w: %v is not between %v and %v", packed.ErrInvalidValue, reciever.Matrix[i0][i1], 0, 1000)}
+			}
+		}
+	}
+	for i0 := range reciever.B {
+		if reciever.B[i0].A < 1 || reciever.B[i0].A > 10 {
+			return &packed.FieldError{Path: fmt.Sprintf("X.B[%d].A", i0), Err: fmt.Errorf("%w: %v is not between %v and %v", packed.ErrInvalidValue, reciever.B[i0].A, 1, 10)}
+		}
+		switch reciever.B[i0].B {
+		case 1, 2, 4:
+		default:
+			return &packed.FieldError{Path: fmt.Sprintf("X.B[%d].B", i0), Err: fmt.Errorf("%w: %v is not one of 1, 2, 4", packed.ErrInvalidValue, reciever.B[i0].B)}
+		}
+	}
+	if reciever.Nested.A < 1 || reciever.Nested.A > 10 {
+		return &packed.FieldError{Path: "X.Nested.A", Err: fmt.Errorf("%w: %v is not between %v and %v", packed.ErrInvalidValue, reciever.Nested.A, 1, 10)}
+	}
+	switch reciever.Nested.B {
+	case 1, 2, 4:
+	default:
+		return &packed.FieldError{Path: "X.Nested.B", Err: fmt.Errorf("%w: %v is not one of 1, 2, 4", packed.ErrInvalidValue, reciever.Nested.B)}
+	}
+	return nil
+}
+
+// AA is 10 bytes, big endian, with 1 byte alignment.
+//
+//	offset  size  field
+//	0       2     Level
+//	2       4     Position
+//	6       2     Temperature
+//	8       2     Offset (12 bits), Gain (4 bits)
+type AA struct {
+	Level       float64
+	Position    float64
+	Temperature float64
+	Offset      float64
+	Gain        float64
+}
+
+func (reciever *AA) Size() int {
+	return 10
+}
+
+func (reciever *AA) ToBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+10 {
+		return 0, packed.ErrShortBuffer
+	}
+	c15.ToBytesBigEndian(&reciever.Level, bytes, index+0)
+	c57.ToBytesLittleEndian(&reciever.Position, bytes, index+2)
+	c25.ToBytesBigEndian(&reciever.Temperature, bytes, index+6)
+	var b0 uint64
+	b0 |= (uint64(c50.Integer(&reciever.Offset)) & 0xFFF) << 4
+	b0 |= (uint64(c26.Integer(&reciever.Gain)) & 0xF)
+	bytes[index+8+1] = byte(b0 >> 0)
+	bytes[index+8+0] = byte(b0 >> 8)
+	return 10, nil
+}
+
+func (reciever *AA) FromBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+10 {
+		return 0, packed.ErrShortBuffer
+	}
+	c15.FromBytesBigEndian(&reciever.Level, bytes, index+0)
+	c57.FromBytesLittleEndian(&reciever.Position, bytes, index+2)
+	c25.FromBytesBigEndian(&reciever.Temperature, bytes, index+6)
+	var b0 uint64
+	b0 |= uint64(bytes[index+8+1]) << 0
+	b0 |= uint64(bytes[index+8+0]) << 8
+	c50.Set(&reciever.Offset, uint64(uint64((b0>>4)&0xFFF)))
+	c26.Set(&reciever.Gain, uint64(uint64((b0>>0)&0xF)))
+	return 10, nil
+}
+
+// AE is at least 10 bytes, big endian, with 1 byte alignment.
+//
+//	offset  size      field
+//	0       1         Type
+//	1       variable  Remaining
+//	2+      variable  ID
+//	3+      variable  Length
+//	4+      variable  Payload
+//	4+      variable  Delta
+//	5+      variable  Offset
+//	6+      1         HasExtra
+//	7+      0 or 1    Extra (when HasExtra)
+//	7+      2         Trailer
+//	9+      1         Total (size of struct)
+type AE struct {
+	Type      uint8
+	Remaining uint32
+	ID        uint64
+	Length    uint64
+	Payload   []byte
+	Delta     int64
+	Offset    int64
+	HasExtra  bool
+	Extra     *uint64
+	Trailer   uint16
+	Total     uint8
+}
+
+func (reciever *AE) Size() int {
+	size := 10
+	size += c10.SizeOf(&reciever.Remaining) - 1
+	size += c19.SizeOf(&reciever.ID) - 1
+	size += c19.SizeOf(&reciever.Length) - 1
+	size += len(reciever.Payload)
+	size += c51.SizeOf(&reciever.Delta) - 1
+	size += c11.SizeOf(&reciever.Offset) - 1
+	if reciever.Extra != nil {
+		size += 1
+		size += c19.SizeOf(&(*reciever.Extra)) - 1
+	}
+	return size
+}
+
+func (reciever *AE) ToBytes(bytes []byte, index int) (int, error) {
+	reciever.Length = uint64(len(reciever.Payload))
+	reciever.HasExtra = reciever.Extra != nil
+	{
+		size := 10
+		size += c10.SizeOf(&reciever.Remaining) - 1
+		size += c19.SizeOf(&reciever.ID) - 1
+		size += c19.SizeOf(&reciever.Length) - 1
+		size += len(reciever.Payload)
+		size += c51.SizeOf(&reciever.Delta) - 1
+		size += c11.SizeOf(&reciever.Offset) - 1
+		if reciever.Extra != nil {
+			size += 1
+			size += c19.SizeOf(&(*reciever.Extra)) - 1
+		}
+		if uint64(size) > 255 {
+			return 0, &packed.FieldError{Path: "AE.Total", Err: packed.ErrInvalidLength}
+		}
+		reciever.Total = uint8(size)
+	}
+	if len(bytes) < index+reciever.Size() {
+		return 0, packed.ErrShortBuffer
+	}
+	start := index
+	c49.ToBytesBigEndian(&reciever.Type, bytes, index+0)
+	index += 1
+	if n, err := c10.ToBytes(&reciever.Remaining, bytes, index); err != nil {
+		return 0, &packed.FieldError{Path: "AE.Remaining", Err: err}
+	} else {
+		index += n
+	}
+	if n, err := c19.ToBytes(&reciever.ID, bytes, index); err != nil {
+		return 0, &packed.FieldError{Path: "AE.ID", Err: err}
+	} else {
+		index += n
+	}
+	if n, err := c19.ToBytes(&reciever.Length, bytes, index); err != nil {
+		return 0, &packed.FieldError{Path: "AE.Length", Err: err}
+	} else {
+		index += n
+	}
+	copy(bytes[index:], reciever.Payload)
+	index += len(reciever.Payload)
+	if n, err := c51.ToBytes(&reciever.Delta, bytes, index); err != nil {
+		return 0, &packed.FieldError{Path: "AE.Delta", Err: err}
+	} else {
+		index += n
+	}
+	if n, err := c11.ToBytes(&reciever.Offset, bytes, index); err != nil {
+		return 0, &packed.FieldError{Path: "AE.Offset", Err: err}
+	} else {
+		index += n
+	}
+	c7.ToBytesBigEndian(&reciever.HasExtra, bytes, index+0)
+	index += 1
+	if reciever.Extra != nil {
+		if n, err := c19.ToBytes(&(*reciever.Extra), bytes, index); err != nil {
+			return 0, &packed.FieldError{Path: "AE.Extra", Err: err}
+		} else {
+			index += n
+		}
+	}
+	c30.ToBytesBigEndian(&reciever.Trailer, bytes, index+0)
+	c49.ToBytesBigEndian(&reciever.Total, bytes, index+2)
+	index += 3
+	return index - start, nil
+}
+
+func (reciever *AE) FromBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+10 {
+		return 0, packed.ErrShortBuffer
+	}
+	start := index
+	c49.FromBytesBigEndian(&reciever.Type, bytes, index+0)
+	index += 1
+	if n, err := c10.FromBytes(&reciever.Remaining, bytes, index); err != nil {
+		return 0, &packed.FieldError{Path: "AE.Remaining", Err: err}
+	} else {
+		index += n
+	}
+	if len(bytes)-index < 8 {
+		return 0, packed.ErrShortBuffer
+	}
+	if n, err := c19.FromBytes(&reciever.ID, bytes, index); err != nil {
+		return 0, &packed.FieldError{Path: "AE.ID", Err: err}
+	} else {
+		index += n
+	}
+	if len(bytes)-index < 7 {
+		return 0, packed.ErrShortBuffer
+	}
+	if n, err := c19.FromBytes(&reciever.Length, bytes, index); err != nil {
+		return 0, &packed.FieldError{Path: "AE.Length", Err: err}
+	} else {
+		index += n
+	}
+	if len(bytes)-index < 6 {
+		return 0, packed.ErrShortBuffer
+	}
+	if int(reciever.Length) < 0 {
+		return 0, &packed.FieldError{Path: "AE.Payload", Err: packed.ErrInvalidLength}
+	}
+	if available := len(bytes) - index - 6; available < 0 || uint64(int(reciever.Length)) > uint64(available) {
+		return 0, &packed.FieldError{Path: "AE.Payload", Err: packed.ErrShortBuffer}
+	}
+	reciever.Payload = make([]byte, int(reciever.Length))
+	copy(reciever.Payload, bytes[index:])
+	index += len(reciever.Payload)
+	if n, err := c51.FromBytes(&reciever.Delta, bytes, index); err != nil {
+		return 0, &packed.FieldError{Path: "AE.Delta", Err: err}
+	} else {
+		index += n
+	}
+	if len(bytes)-index < 5 {
+		return 0, packed.ErrShortBuffer
+	}
+	if n, err := c11.FromBytes(&reciever.Offset, bytes, index); err != nil {
+		return 0, &packed.FieldError{Path: "AE.Offset", Err: err}
+	} else {
+		index += n
+	}
+	if len(bytes)-index < 4 {
+		return 0, packed.ErrShortBuffer
+	}
+	c7.FromBytesBigEndian(&reciever.HasExtra, bytes, index+0)
+	index += 1
+	if reciever.HasExtra {
+		if len(bytes)-index < 4 {
+			return 0, &packed.FieldError{Path: "AE.Extra", Err: packed.ErrShortBuffer}
+		}
+		reciever.Extra = new(uint64)
+		if n, err := c19.FromBytes(&(*reciever.Extra), bytes, index); err != nil {
+			return 0, &packed.FieldError{Path: "AE.Extra", Err: err}
+		} else {
+			index += n
+		}
+		if len(bytes)-index < 3 {
+			return 0, packed.ErrShortBuffer
+		}
+	} else {
+		reciever.Extra = nil
+	}
+	c30.FromBytesBigEndian(&reciever.Trailer, bytes, index+0)
+	c49.FromBytesBigEndian(&reciever.Total, bytes, index+2)
+	{
+		size := 10
+		size += c10.SizeOf(&reciever.Remaining) - 1
+		size += c19.SizeOf(&reciever.ID) - 1
+		size += c19.SizeOf(&reciever.Length) - 1
+		size += len(reciever.Payload)
+		size += c51.SizeOf(&reciever.Delta) - 1
+		size += c11.SizeOf(&reciever.Offset) - 1
+		if reciever.Extra != nil {
+			size += 1
+			size += c19.SizeOf(&(*reciever.Extra)) - 1
+		}
+		if uint64(size) != uint64(reciever.Total) {
+			return 0, &packed.FieldError{Path: "AE.Total", Err: fmt.Errorf("%w: expected %d, got %d", packed.ErrComputedMismatch, size, reciever.Total)}
+		}
+	}
+	index += 3
+	return index - start, nil
+}
+
+// J is 2 bytes, little endian, with 1 byte alignment.
+//
+//	offset  size  field
+//	0       2     A (6 bits), B (10 bits)
+type J struct {
+	A uint8
+	B types.ExampleBitsType
+}
+
+func (reciever *J) Size() int {
+	return 2
+}
+
+func (reciever *J) ToBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+2 {
+		return 0, packed.ErrShortBuffer
+	}
+	var b0 uint64
+	b0 |= (uint64(reciever.A) & 0x3F)
+	b0 |= (uint64(reciever.B.Integer()) & 0x3FF) << 6
+	bytes[index+0+0] = byte(b0 >> 0)
+	bytes[index+0+1] = byte(b0 >> 8)
+	return 2, nil
+}
+
+func (reciever *J) FromBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+2 {
+		return 0, packed.ErrShortBuffer
+	}
+	var b0 uint64
+	b0 |= uint64(bytes[index+0+0]) << 0
+	b0 |= uint64(bytes[index+0+1]) << 8
+	reciever.A = uint8(uint64((b0 >> 0) & 0x3F))
+	reciever.B.Set(uint16(uint64((b0 >> 6) & 0x3FF)))
+	return 2, nil
 }
 
-type B struct {
-	A uint8  `json:"a" xml:"a"`
-	B uint16 `json:"b" xml:"b"`
-	C uint32 `json:"c" xml:"c"`
-	D int64  `json:"d" xml:"d"`
-	E int8   `json:"e" xml:"e"`
-	F bool   `json:"f" xml:"f"`
-	G int8   `json:"g" xml:"g"`
+// K is 2 bytes, big endian, with 1 byte alignment.
+//
+//	offset  size  field
+//	0       2     A (6 bits), B (10 bits)
+type K struct {
+	A uint8
+	B types.ExampleBitsType
+}
+
+func (reciever *K) Size() int {
+	return 2
+}
+
+func (reciever *K) ToBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+2 {
+		return 0, packed.ErrShortBuffer
+	}
+	var b0 uint64
+	b0 |= (uint64(reciever.A) & 0x3F) << 10
+	b0 |= (uint64(reciever.B.Integer()) & 0x3FF)
+	bytes[index+0+1] = byte(b0 >> 0)
+	bytes[index+0+0] = byte(b0 >> 8)
+	return 2, nil
+}
+
+func (reciever *K) FromBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+2 {
+		return 0, packed.ErrShortBuffer
+	}
+	var b0 uint64
+	b0 |= uint64(bytes[index+0+1]) << 0
+	b0 |= uint64(bytes[index+0+0]) << 8
+	reciever.A = uint8(uint64((b0 >> 10) & 0x3F))
+	reciever.B.Set(uint16(uint64((b0 >> 0) & 0x3FF)))
+	return 2, nil
+}
+
+// N is at least 4 bytes, little endian, with 1 byte alignment.
+//
+//	offset  size      field
+//	0       2         Count
+//	2       1         Length (4 bits), Flag (4 bits)
+//	3       variable  Values
+//	3+      variable  Name
+//	3+      1         Trailer
+type N struct {
+	Count   uint16
+	Length  uint8
+	Flag    uint8
+	Values  []int32
+	Name    string
+	Trailer uint8
+}
+
+func (reciever *N) Size() int {
+	size := 4
+	size += len(reciever.Values) * 4
+	size += len(reciever.Name)
+	return size
+}
+
+func (reciever *N) ToBytes(bytes []byte, index int) (int, error) {
+	if uint64(len(reciever.Values)) > 65535 {
+		return 0, &packed.FieldError{Path: "N.Values", Err: packed.ErrInvalidLength}
+	}
+	reciever.Count = uint16(len(reciever.Values))
+	if uint64(len(reciever.Name)) > 15 {
+		return 0, &packed.FieldError{Path: "N.Name", Err: packed.ErrInvalidLength}
+	}
+	reciever.Length = uint8(len(reciever.Name))
+	if len(bytes) < index+reciever.Size() {
+		return 0, packed.ErrShortBuffer
+	}
+	start := index
+	c30.ToBytesLittleEndian(&reciever.Count, bytes, index+0)
+	var b0 uint64
+	b0 |= (uint64(reciever.Length) & 0xF)
+	b0 |= (uint64(reciever.Flag) & 0xF) << 4
+	bytes[index+2+0] = byte(b0 >> 0)
+	index += 3
+	for i0 := 0; i0 < len(reciever.Values); i0++ {
+		c4.ToBytesLittleEndian(&reciever.Values[i0], bytes, index)
+		index += 4
+	}
+	copy(bytes[index:], reciever.Name)
+	index += len(reciever.Name)
+	c49.ToBytesLittleEndian(&reciever.Trailer, bytes, index+0)
+	index += 1
+	return index - start, nil
+}
+
+func (reciever *N) FromBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+4 {
+		return 0, packed.ErrShortBuffer
+	}
+	start := index
+	c30.FromBytesLittleEndian(&reciever.Count, bytes, index+0)
+	var b0 uint64
+	b0 |= uint64(bytes[index+2+0]) << 0
+	reciever.Length = uint8(uint64((b0 >> 0) & 0xF))
+	reciever.Flag = uint8(uint64((b0 >> 4) & 0xF))
+	index += 3
+	if available := len(bytes) - index - 1; available < 0 || uint64(int(reciever.Count)) > uint64(available)/4 {
+		return 0, &packed.FieldError{Path: "N.Values", Err: packed.ErrShortBuffer}
+	}
+	reciever.Values = make([]int32, int(reciever.Count))
+	for i0 := 0; i0 < len(reciever.Values); i0++ {
+		c4.FromBytesLittleEndian(&reciever.Values[i0], bytes, index)
+		index += 4
+	}
+	if available := len(bytes) - index - 1; available < 0 || uint64(int(reciever.Length)) > uint64(available) {
+		return 0, &packed.FieldError{Path: "N.Name", Err: packed.ErrShortBuffer}
+	}
+	reciever.Name = string(bytes[index : index+int(reciever.Length)])
+	index += len(reciever.Name)
+	c49.FromBytesLittleEndian(&reciever.Trailer, bytes, index+0)
+	index += 1
+	return index - start, nil
+}
+
+// AG is 40 bytes, big endian, with 1 byte alignment.
+//
+//	offset  size  field
+//	0       4     Created
+//	4       8     Modified
+//	12      8     Written
+//	20      8     Synchronized
+//	28      6     Fix
+//	34      4     Archived
+//	38      2     Timeout
+type AG struct {
+	Created      time.Time
+	Modified     time.Time
+	Written      time.Time
+	Synchronized time.Time
+	Fix          time.Time
+	Archived     time.Time
+	Timeout      time.Duration
+}
+
+func (reciever *AG) Size() int {
+	return 40
+}
+
+func (reciever *AG) ToBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+40 {
+		return 0, packed.ErrShortBuffer
+	}
+	if err := c17.ToBytesBigEndian(&reciever.Created, bytes, index+0); err != nil {
+		return 0, &packed.FieldError{Path: "AG.Created", Err: err}
+	}
+	if err := c39.ToBytesLittleEndian(&reciever.Modified, bytes, index+4); err != nil {
+		return 0, &packed.FieldError{Path: "AG.Modified", Err: err}
+	}
+	if err := c37.ToBytesLittleEndian(&reciever.Written, bytes, index+12); err != nil {
+		return 0, &packed.FieldError{Path: "AG.Written", Err: err}
+	}
+	if err := c64.ToBytesBigEndian(&reciever.Synchronized, bytes, index+20); err != nil {
+		return 0, &packed.FieldError{Path: "AG.Synchronized", Err: err}
+	}
+	if err := c40.ToBytesBigEndian(&reciever.Fix, bytes, index+28); err != nil {
+		return 0, &packed.FieldError{Path: "AG.Fix", Err: err}
+	}
+	if err := c41.ToBytesLittleEndian(&reciever.Archived, bytes, index+34); err != nil {
+		return 0, &packed.FieldError{Path: "AG.Archived", Err: err}
+	}
+	if err := c65.ToBytesBigEndian(&reciever.Timeout, bytes, index+38); err != nil {
+		return 0, &packed.FieldError{Path: "AG.Timeout", Err: err}
+	}
+	return 40, nil
+}
+
+func (reciever *AG) FromBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+40 {
+		return 0, packed.ErrShortBuffer
+	}
+	if err := c17.FromBytesBigEndian(&reciever.Created, bytes, index+0); err != nil {
+		return 0, &packed.FieldError{Path: "AG.Created", Err: err}
+	}
+	if err := c39.FromBytesLittleEndian(&reciever.Modified, bytes, index+4); err != nil {
+		return 0, &packed.FieldError{Path: "AG.Modified", Err: err}
+	}
+	if err := c37.FromBytesLittleEndian(&reciever.Written, bytes, index+12); err != nil {
+		return 0, &packed.FieldError{Path: "AG.Written", Err: err}
+	}
+	c64.FromBytesBigEndian(&reciever.Synchronized, bytes, index+20)
+	if err := c40.FromBytesBigEndian(&reciever.Fix, bytes, index+28); err != nil {
+		return 0, &packed.FieldError{Path: "AG.Fix", Err: err}
+	}
+	if err := c41.FromBytesLittleEndian(&reciever.Archived, bytes, index+34); err != nil {
+		return 0, &packed.FieldError{Path: "AG.Archived", Err: err}
+	}
+	if err := c65.FromBytesBigEndian(&reciever.Timeout, bytes, index+38); err != nil {
+		return 0, &packed.FieldError{Path: "AG.Timeout", Err: err}
+	}
+	return 40, nil
+}
+
+// AH is 64 bytes, big endian, with 1 byte alignment.
+//
+//	offset  size  field
+//	0       16    Identifier
+//	16      16    Class
+//	32      32    Members
+type AH struct {
+	Identifier packed.UUID
+	Class      packed.UUID
+	Members    [2]packed.UUID
+}
+
+func (reciever *AH) Size() int {
+	return 64
+}
+
+func (reciever *AH) ToBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+64 {
+		return 0, packed.ErrShortBuffer
+	}
+	c12.ToBytesBigEndian(&reciever.Identifier, bytes, index+0)
+	c1.ToBytesBigEndian(&reciever.Class, bytes, index+16)
+	o32 := index + 32
+	for i0 := 0; i0 < 2; i0++ {
+		c1.ToBytesBigEndian(&reciever.Members[i0], bytes, o32)
+		o32 += 16
+	}
+	return 64, nil
+}
+
+func (reciever *AH) FromBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+64 {
+		return 0, packed.ErrShortBuffer
+	}
+	c12.FromBytesBigEndian(&reciever.Identifier, bytes, index+0)
+	c1.FromBytesBigEndian(&reciever.Class, bytes, index+16)
+	o32 := index + 32
+	for i0 := 0; i0 < 2; i0++ {
+		c1.FromBytesBigEndian(&reciever.Members[i0], bytes, o32)
+		o32 += 16
+	}
+	return 64, nil
+}
+
+// AN is at least 26 bytes, big endian, with 1 byte alignment.
+//
+//	offset  size      field
+//	0       2         Background
+//	2       8         Palette
+//	10      8         Tiles
+//	18      4         Icon
+//	22      3         Left (4 bits), Right (4 bits), Overlay (16 bits)
+//	25      1         Count
+//	26      variable  Pixels
+type AN struct {
+	Background color.RGBA
+	Palette    [2]color.RGBA
+	Tiles      [2]color.RGBA
+	Icon       [2]color.RGBA
+	Left       color.Gray
+	Right      color.Gray
+	Overlay    color.RGBA
+	Count      uint8
+	Pixels     []color.RGBA
+}
+
+func (reciever *AN) Size() int {
+	size := 26
+	size += len(reciever.Pixels) * 4
+	return size
+}
+
+func (reciever *AN) ToBytes(bytes []byte, index int) (int, error) {
+	if uint64(len(reciever.Pixels)) > 255 {
+		return 0, &packed.FieldError{Path: "AN.Pixels", Err: packed.ErrInvalidLength}
+	}
+	reciever.Count = uint8(len(reciever.Pixels))
+	if len(bytes) < index+reciever.Size() {
+		return 0, packed.ErrShortBuffer
+	}
+	start := index
+	c73.ToBytesLittleEndian(&reciever.Background, bytes, index+0)
+	o2 := index + 2
+	copy(bytes[o2:], unsafe.Slice((*byte)(unsafe.Pointer(unsafe.SliceData(reciever.Palette[:]))), 2*4))
+	o2 += 2 * 4
+	o10 := index + 10
+	copy(bytes[o10:], unsafe.Slice((*byte)(unsafe.Pointer(unsafe.SliceData(reciever.Tiles[:]))), 2*4))
+	o10 += 2 * 4
+	o18 := index + 18
+	for i0 := 0; i0 < 2; i0++ {
+		c29.ToBytesBigEndian(&reciever.Icon[i0], bytes, o18)
+		o18 += 2
+	}
+	var b0 uint64
+	b0 |= (uint64(c42.Integer(&reciever.Left)) & 0xF) << 20
+	b0 |= (uint64(c42.Integer(&reciever.Right)) & 0xF) << 16
+	b0 |= (uint64(c54.Integer(&reciever.Overlay)) & 0xFFFF)
+	bytes[index+22+2] = byte(b0 >> 0)
+	bytes[index+22+1] = byte(b0 >> 8)
+	bytes[index+22+0] = byte(b0 >> 16)
+	c49.ToBytesBigEndian(&reciever.Count, bytes, index+25)
+	index += 26
+	copy(bytes[index:], unsafe.Slice((*byte)(unsafe.Pointer(unsafe.SliceData(reciever.Pixels[:]))), len(reciever.Pixels)*4))
+	index += len(reciever.Pixels) * 4
+	return index - start, nil
+}
+
+func (reciever *AN) FromBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+26 {
+		return 0, packed.ErrShortBuffer
+	}
+	start := index
+	c73.FromBytesLittleEndian(&reciever.Background, bytes, index+0)
+	o2 := index + 2
+	copy(unsafe.Slice((*byte)(unsafe.Pointer(unsafe.SliceData(reciever.Palette[:]))), 2*4), bytes[o2:])
+	o2 += 2 * 4
+	o10 := index + 10
+	copy(unsafe.Slice((*byte)(unsafe.Pointer(unsafe.SliceData(reciever.Tiles[:]))), 2*4), bytes[o10:])
+	o10 += 2 * 4
+	o18 := index + 18
+	for i0 := 0; i0 < 2; i0++ {
+		c29.FromBytesBigEndian(&reciever.Icon[i0], bytes, o18)
+		o18 += 2
+	}
+	var b0 uint64
+	b0 |= uint64(bytes[index+22+2]) << 0
+	b0 |= uint64(bytes[index+22+1]) << 8
+	b0 |= uint64(bytes[index+22+0]) << 16
+	c42.Set(&reciever.Left, uint64(uint64((b0>>20)&0xF)))
+	c42.Set(&reciever.Right, uint64(uint64((b0>>16)&0xF)))
+	c54.Set(&reciever.Overlay, uint64(uint64((b0>>0)&0xFFFF)))
+	c49.FromBytesBigEndian(&reciever.Count, bytes, index+25)
+	index += 26
+	if available := len(bytes) - index - 0; available < 0 || uint64(int(reciever.Count)) > uint64(available)/4 {
+		return 0, &packed.FieldError{Path: "AN.Pixels", Err: packed.ErrShortBuffer}
+	}
+	reciever.Pixels = make([]color.RGBA, int(reciever.Count))
+	copy(unsafe.Slice((*byte)(unsafe.Pointer(unsafe.SliceData(reciever.Pixels[:]))), len(reciever.Pixels)*4), bytes[index:])
+	index += len(reciever.Pixels) * 4
+	return index - start, nil
+}
+
+// AQ is at least 1 bytes, little endian, with 1 byte alignment.
+//
+//	offset  size      field
+//	0       1         Kind
+//	1       variable  Payload
+type AQ struct {
+	Kind    uint8
+	Payload AQPayload
+}
+
+type AQPayload interface {
+	Size() int
+	ToBytes(bytes []byte, index int) (int, error)
+	FromBytes(bytes []byte, index int) (int, error)
+	Validate() error
+	isAQPayload()
+}
+
+func (*XA) isAQPayload() {}
+
+func (reciever *AQ) Size() int {
+	size := 1
+	if reciever.Payload != nil {
+		size += reciever.Payload.Size()
+	}
+	return size
+}
+
+func (reciever *AQ) ToBytes(bytes []byte, index int) (int, error) {
+	switch reciever.Payload.(type) {
+	case *XA:
+		reciever.Kind = uint8(1)
+	default:
+		return 0, &packed.FieldError{Path: "AQ.Payload", Err: packed.ErrUnknownVariant}
+	}
+	if len(bytes) < index+reciever.Size() {
+		return 0, packed.ErrShortBuffer
+	}
+	start := index
+	c49.ToBytesLittleEndian(&reciever.Kind, bytes, index+0)
+	index += 1
+	if n, err := reciever.Payload.ToBytes(bytes, index+0); err != nil {
+		return 0, &packed.FieldError{Path: "AQ.Payload", Err: err}
+	} else {
+		index += n
+	}
+	return index - start, nil
+}
+
+func (reciever *AQ) FromBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+1 {
+		return 0, packed.ErrShortBuffer
+	}
+	start := index
+	c49.FromBytesLittleEndian(&reciever.Kind, bytes, index+0)
+	index += 1
+	switch reciever.Kind {
+	case 1:
+		reciever.Payload = new(XA)
+	default:
+		return 0, &packed.FieldError{Path: "AQ.Payload", Err: packed.ErrUnknownVariant}
+	}
+	if n, err := reciever.Payload.FromBytes(bytes, index+0); err != nil {
+		return 0, &packed.FieldError{Path: "AQ.Payload", Err: err}
+	} else {
+		index += n
+	}
+	if len(bytes)-index < 0 {
+		return 0, &packed.FieldError{Path: "AQ.Payload", Err: packed.ErrShortBuffer}
+	}
+	return index - start, nil
+}
+
+func (reciever *AQ) Validate() error {
+	if reciever.Payload != nil {
+		if err := reciever.Payload.Validate(); err != nil {
+			var fieldError *packed.FieldError
+			if !errors.As(err, &fieldError) {
+				return &packed.FieldError{Path: "AQ.Payload", Err: err}
+			}
+			if _, path, found := strings.Cut(fieldError.Path, "."); found {
+				return &packed.FieldError{Path: "AQ.Payload" + "." + path, Err: fieldError.Err}
+			}
+			return &packed.FieldError{Path: "AQ.Payload", Err: fieldError.Err}
+		}
+	}
+	return nil
+}
+
+// Y is 9 bytes, big endian, with 1 byte alignment.
+//
+//	offset  size  field
+//	0       1     Color
+//	1       1     Strict
+//	2       3     Palette
+//	5       2     Direction
+//	7       1     Low (3 bits), High (5 bits)
+//	8       1     Default (const 0x2)
+type Y struct {
+	Color     Color
+	Strict    Color
+	Palette   [3]Color
+	Direction Direction
+	Low       Color
+	High      Color
+}
+
+func (reciever *Y) Default() Color {
+	return 0x2
+}
+
+func (reciever *Y) Size() int {
+	return 9
+}
+
+func (reciever *Y) ToBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+9 {
+		return 0, packed.ErrShortBuffer
+	}
+	var r0 uint8
+	var r1 int16
+	r0 = uint8(reciever.Color)
+	c49.ToBytesBigEndian(&r0, bytes, index+0)
+	r0 = uint8(reciever.Strict)
+	c49.ToBytesBigEndian(&r0, bytes, index+1)
+	o2 := index + 2
+	for i0 := 0; i0 < 3; i0++ {
+		r0 = uint8(reciever.Palette[i0])
+		c49.ToBytesBigEndian(&r0, bytes, o2)
+		o2 += 1
+	}
+	r1 = int16(reciever.Direction)
+	c18.ToBytesBigEndian(&r1, bytes, index+5)
+	var b0 uint64
+	b0 |= (uint64(reciever.Low) & 0x7) << 5
+	b0 |= (uint64(reciever.High) & 0x1F)
+	bytes[index+7+0] = byte(b0 >> 0)
+	copy(bytes[index+8:], "\x02")
+	return 9, nil
+}
+
+func (reciever *Y) FromBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+9 {
+		return 0, packed.ErrShortBuffer
+	}
+	var r0 uint8
+	var r1 int16
+	c49.FromBytesBigEndian(&r0, bytes, index+0)
+	reciever.Color = Color(r0)
+	c49.FromBytesBigEndian(&r0, bytes, index+1)
+	reciever.Strict = Color(r0)
+	if !reciever.Strict.IsValid() {
+		return 0, &packed.FieldError{Path: "Y.Strict", Err: fmt.Errorf("%w: unknown Color %d", packed.ErrInvalidValue, uint8(reciever.Strict))}
+	}
+	o2 := index + 2
+	for i0 := 0; i0 < 3; i0++ {
+		c49.FromBytesBigEndian(&r0, bytes, o2)
+		reciever.Palette[i0] = Color(r0)
+		o2 += 1
+	}
+	c18.FromBytesBigEndian(&r1, bytes, index+5)
+	reciever.Direction = Direction(r1)
+	var b0 uint64
+	b0 |= uint64(bytes[index+7+0]) << 0
+	reciever.Low = Color(uint64((b0 >> 5) & 0x7))
+	reciever.High = Color(uint64((b0 >> 0) & 0x1F))
+	if !reciever.High.IsValid() {
+		return 0, &packed.FieldError{Path: "Y.High", Err: fmt.Errorf("%w: unknown Color %d", packed.ErrInvalidValue, uint8(reciever.High))}
+	}
+	if string(bytes[index+8:index+8+1]) != "\x02" {
+		return 0, &packed.FieldError{Path: "Y.Default", Err: fmt.Errorf("%w: expected % x, got % x", packed.ErrConstMismatch, "\x02", bytes[index+8:index+8+1])}
+	}
+	return 9, nil
+}
+
+func (reciever *Y) Validate() error {
+	if !reciever.Direction.IsValid() {
+		return &packed.FieldError{Path: "Y.Direction", Err: fmt.Errorf("%w: %v is not a valid value", packed.ErrInvalidValue, reciever.Direction)}
+	}
+	return nil
+}
+
+// AC is 23 bytes, big endian, with 1 byte alignment.
+//
+//	offset  size  field
+//	0       3     Sample
+//	3       6     Timestamp
+//	9       6     Samples
+//	15      5     Wide
+//	20      3     Count (count of Samples)
+type AC struct {
+	Sample    int32
+	Timestamp uint64
+	Samples   [2]int32
+	Wide      int
+	Count     uint32
+}
+
+func (reciever *AC) Size() int {
+	return 23
+}
+
+func (reciever *AC) ToBytes(bytes []byte, index int) (int, error) {
+	{
+		count := 0
+		var zero int32
+		for _, element := range reciever.Samples {
+			if element != zero {
+				count++
+			}
+		}
+		if uint64(count) > 16777215 {
+			return 0, &packed.FieldError{Path: "AC.Count", Err: packed.ErrInvalidLength}
+		}
+		reciever.Count = uint32(count)
+	}
+	if len(bytes) < index+23 {
+		return 0, packed.ErrShortBuffer
+	}
+	var r0 int64
+	c23.ToBytesBigEndian(&reciever.Sample, bytes, index+0)
+	c32.ToBytesLittleEndian(&reciever.Timestamp, bytes, index+3)
+	o9 := index + 9
+	for i0 := 0; i0 < 2; i0++ {
+		c23.ToBytesBigEndian(&reciever.Samples[i0], bytes, o9)
+		o9 += 3
+	}
+	r0 = int64(reciever.Wide)
+	c33.ToBytesBigEndian(&r0, bytes, index+15)
+	c69.ToBytesBigEndian(&reciever.Count, bytes, index+20)
+	return 23, nil
+}
+
+func (reciever *AC) FromBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+23 {
+		return 0, packed.ErrShortBuffer
+	}
+	var r0 int64
+	c23.FromBytesBigEndian(&reciever.Sample, bytes, index+0)
+	c32.FromBytesLittleEndian(&reciever.Timestamp, bytes, index+3)
+	o9 := index + 9
+	for i0 := 0; i0 < 2; i0++ {
+		c23.FromBytesBigEndian(&reciever.Samples[i0], bytes, o9)
+		o9 += 3
+	}
+	c33.FromBytesBigEndian(&r0, bytes, index+15)
+	reciever.Wide = int(r0)
+	c69.FromBytesBigEndian(&reciever.Count, bytes, index+20)
+	return 23, nil
+}
+
+// AO is at least 9 bytes, big endian, with 1 byte alignment.
+//
+//	offset  size      field
+//	0       8         Count
+//	8       variable  Items
+//	8+      1         Trailer
+type AO struct {
+	Count   uint64
+	Items   []uint16
+	Trailer uint8
+}
+
+func (reciever *AO) Size() int {
+	size := 9
+	size += len(reciever.Items) * 2
+	return size
+}
+
+func (reciever *AO) ToBytes(bytes []byte, index int) (int, error) {
+	reciever.Count = uint64(len(reciever.Items))
+	if len(bytes) < index+reciever.Size() {
+		return 0, packed.ErrShortBuffer
+	}
+	start := index
+	c20.ToBytesBigEndian(&reciever.Count, bytes, index+0)
+	index += 8
+	for i0 := 0; i0 < len(reciever.Items); i0++ {
+		c30.ToBytesBigEndian(&reciever.Items[i0], bytes, index)
+		index += 2
+	}
+	c49.ToBytesBigEndian(&reciever.Trailer, bytes, index+0)
+	index += 1
+	return index - start, nil
+}
+
+func (reciever *AO) FromBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+9 {
+		return 0, packed.ErrShortBuffer
+	}
+	start := index
+	c20.FromBytesBigEndian(&reciever.Count, bytes, index+0)
+	index += 8
+	if int(reciever.Count) < 0 {
+		return 0, &packed.FieldError{Path: "AO.Items", Err: packed.ErrInvalidLength}
+	}
+	if available := len(bytes) - index - 1; available < 0 || uint64(int(reciever.Count)) > uint64(available)/2 {
+		return 0, &packed.FieldError{Path: "AO.Items", Err: packed.ErrShortBuffer}
+	}
+	reciever.Items = make([]uint16, int(reciever.Count))
+	for i0 := 0; i0 < len(reciever.Items); i0++ {
+		c30.FromBytesBigEndian(&reciever.Items[i0], bytes, index)
+		index += 2
+	}
+	c49.FromBytesBigEndian(&reciever.Trailer, bytes, index+0)
+	index += 1
+	return index - start, nil
+}
+
+// I is 11 bytes, little endian, with 1 byte alignment.
+//
+//	offset  size  field
+//	0       4     A
+//	4       2     B
+//	6       4     C
+//	10      1     D
+type I struct {
+	A types.ExampleEnum
+	B [2]types.ExampleEnum
+	C [2]H
+	D types.ExampleEnumString
+}
+
+func (reciever *I) Size() int {
+	return 11
+}
+
+func (reciever *I) ToBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+11 {
+		return 0, packed.ErrShortBuffer
+	}
+	var r1 int8
+	var r2 int16
+	var r3 string
+	var r0 int32
+	r0 = int32(reciever.A)
+	c4.ToBytesLittleEndian(&r0, bytes, index+0)
+	o4 := index + 4
+	for i0 := 0; i0 < 2; i0++ {
+		r1 = int8(reciever.B[i0])
+		c38.ToBytesLittleEndian(&r1, bytes, o4)
+		o4 += 1
+	}
+	o6 := index + 6
+	for i0 := 0; i0 < 2; i0++ {
+		r2 = int16(reciever.C[i0].A)
+		c18.ToBytesBigEndian(&r2, bytes, o6)
+		o6 += 2
+	}
+	r3 = string(reciever.D)
+	c45.ToBytesLittleEndian(&r3, bytes, index+10)
+	return 11, nil
+}
+
+func (reciever *I) FromBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+11 {
+		return 0, packed.ErrShortBuffer
+	}
+	var r1 int8
+	var r2 int16
+	var r3 string
+	var r0 int32
+	c4.FromBytesLittleEndian(&r0, bytes, index+0)
+	reciever.A = types.ExampleEnum(r0)
+	o4 := index + 4
+	for i0 := 0; i0 < 2; i0++ {
+		c38.FromBytesLittleEndian(&r1, bytes, o4)
+		reciever.B[i0] = types.ExampleEnum(r1)
+		o4 += 1
+	}
+	o6 := index + 6
+	for i0 := 0; i0 < 2; i0++ {
+		c18.FromBytesBigEndian(&r2, bytes, o6)
+		reciever.C[i0].A = types.ExampleEnum(r2)
+		o6 += 2
+	}
+	c45.FromBytesLittleEndian(&r3, bytes, index+10)
+	reciever.D = types.ExampleEnumString(r3)
+	return 11, nil
+}
+
+// O is at least 6 bytes, big endian, with 1 byte alignment.
+//
+//	offset  size      field
+//	0       4         A
+//	4+      1         DataLength
+//	5+      variable  Data
+//	5+      1         Count
+//	6+      variable  Items
+type O struct {
+	A          N
+	DataLength uint8
+	Data       []byte
+	Count      types.ExampleEnum
+	Items      []H
+}
+
+func (reciever *O) Size() int {
+	size := 6
+	size += len(reciever.A.Values) * 4
+	size += len(reciever.A.Name)
+	size += len(reciever.Data)
+	size += len(reciever.Items) * 2
+	return size
+}
+
+func (reciever *O) ToBytes(bytes []byte, index int) (int, error) {
+	if uint64(len(reciever.A.Values)) > 65535 {
+		return 0, &packed.FieldError{Path: "O.A.Values", Err: packed.ErrInvalidLength}
+	}
+	reciever.A.Count = uint16(len(reciever.A.Values))
+	if uint64(len(reciever.A.Name)) > 15 {
+		return 0, &packed.FieldError{Path: "O.A.Name", Err: packed.ErrInvalidLength}
+	}
+	reciever.A.Length = uint8(len(reciever.A.Name))
+	if uint64(len(reciever.Data)) > 255 {
+		return 0, &packed.FieldError{Path: "O.Data", Err: packed.ErrInvalidLength}
+	}
+	reciever.DataLength = uint8(len(reciever.Data))
+	if uint64(len(reciever.Items)) > 127 {
+		return 0, &packed.FieldError{Path: "O.Items", Err: packed.ErrInvalidLength}
+	}
+	reciever.Count = types.ExampleEnum(len(reciever.Items))
+	if len(bytes) < index+reciever.Size() {
+		return 0, packed.ErrShortBuffer
+	}
+	start := index
+	var r0 int8
+	var r1 int16
+	c30.ToBytesBigEndian(&reciever.A.Count, bytes, index+0)
+	var b0 uint64
+	b0 |= (uint64(reciever.A.Length) & 0xF) << 4
+	b0 |= (uint64(reciever.A.Flag) & 0xF)
+	bytes[index+2+0] = byte(b0 >> 0)
+	index += 3
+	for i0 := 0; i0 < len(reciever.A.Values); i0++ {
+		c4.ToBytesBigEndian(&reciever.A.Values[i0], bytes, index)
+		index += 4
+	}
+	copy(bytes[index:], reciever.A.Name)
+	index += len(reciever.A.Name)
+	c49.ToBytesBigEndian(&reciever.A.Trailer, bytes, index+0)
+	c49.ToBytesBigEndian(&reciever.DataLength, bytes, index+1)
+	index += 2
+	copy(bytes[index:], reciever.Data)
+	index += len(reciever.Data)
+	r0 = int8(reciever.Count)
+	c38.ToBytesBigEndian(&r0, bytes, index+0)
+	index += 1
+	for i0 := 0; i0 < len(reciever.Items); i0++ {
+		r1 = int16(reciever.Items[i0].A)
+		c18.ToBytesBigEndian(&r1, bytes, index)
+		index += 2
+	}
+	return index - start, nil
+}
+
+func (reciever *O) FromBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+6 {
+		return 0, packed.ErrShortBuffer
+	}
+	start := index
+	var r1 int16
+	var r0 int8
+	c30.FromBytesBigEndian(&reciever.A.Count, bytes, index+0)
+	var b0 uint64
+	b0 |= uint64(bytes[index+2+0]) << 0
+	reciever.A.Length = uint8(uint64((b0 >> 4) & 0xF))
+	reciever.A.Flag = uint8(uint64((b0 >> 0) & 0xF))
+	index += 3
+	if available := len(bytes) - index - 3; available < 0 || uint64(int(reciever.A.Count)) > uint64(available)/4 {
+		return 0, &packed.FieldError{Path: "O.A.Values", Err: packed.ErrShortBuffer}
+	}
+	reciever.A.Values = make([]int32, int(reciever.A.Count))
+	for i0 := 0; i0 < len(reciever.A.Values); i0++ {
+		c4.FromBytesBigEndian(&reciever.A.Values[i0], bytes, index)
+		index += 4
+	}
+	if available := len(bytes) - index - 3; available < 0 || uint64(int(reciever.A.Length)) > uint64(available) {
+		return 0, &packed.FieldError{Path: "O.A.Name", Err: packed.ErrShortBuffer}
+	}
+	reciever.A.Name = string(bytes[index : index+int(reciever.A.Length)])
+	index += len(reciever.A.Name)
+	c49.FromBytesBigEndian(&reciever.A.Trailer, bytes, index+0)
+	c49.FromBytesBigEndian(&reciever.DataLength, bytes, index+1)
+	index += 2
+	if available := len(bytes) - index - 1; available < 0 || uint64(int(reciever.DataLength)) > uint64(available) {
+		return 0, &packed.FieldError{Path: "O.Data", Err: packed.ErrShortBuffer}
+	}
+	reciever.Data = make([]byte, int(reciever.DataLength))
+	copy(reciever.Data, bytes[index:])
+	index += len(reciever.Data)
+	c38.FromBytesBigEndian(&r0, bytes, index+0)
+	reciever.Count = types.ExampleEnum(r0)
+	index += 1
+	if int(reciever.Count) < 0 {
+		return 0, &packed.FieldError{Path: "O.Items", Err: packed.ErrInvalidLength}
+	}
+	if available := len(bytes) - index - 0; available < 0 || uint64(int(reciever.Count)) > uint64(available)/2 {
+		return 0, &packed.FieldError{Path: "O.Items", Err: packed.ErrShortBuffer}
+	}
+	reciever.Items = make([]H, int(reciever.Count))
+	for i0 := 0; i0 < len(reciever.Items); i0++ {
+		c18.FromBytesBigEndian(&r1, bytes, index)
+		reciever.Items[i0].A = types.ExampleEnum(r1)
+		index += 2
+	}
+	return index - start, nil
 }
 
-func (reciever *B) Size() int {
-	return 9
+// PFlags is 1 bytes, big endian, with 1 byte alignment.
+//
+//	offset  size  field
+//	0       1     HasTimestamp (1 bits), HasKind (1 bits), HasValues (1 bits), Reserved (5 bits)
+type PFlags struct {
+	HasTimestamp bool
+	HasKind      bool
+	HasValues    bool
+	Reserved     uint8
+}
+
+func (reciever *PFlags) Size() int {
+	return 1
 }
 
-func (reciever *B) ToBytes(bytes []byte, index int) {
+func (reciever *PFlags) ToBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+1 {
+		return 0, packed.ErrShortBuffer
+	}
 	var b0 uint64
-	b0 |= (uint64(reciever.A) & 0xF) << 60
-	b0 |= (uint64(reciever.B) & 0x3FF) << 50
-	b0 |= (uint64(reciever.C) & 0xFFFFF) << 30
-	b0 |= (uint64(reciever.D) & 0x3FFFFFFF)
-	bytes[index+0+7] = byte(b0 >> 0)
-	bytes[index+0+6] = byte(b0 >> 8)
-	bytes[index+0+5] = byte(b0 >> 16)
-	bytes[index+0+4] = byte(b0 >> 24)
-	bytes[index+0+3] = byte(b0 >> 32)
-	bytes[index+0+2] = byte(b0 >> 40)
-	bytes[index+0+1] = byte(b0 >> 48)
-	bytes[index+0+0] = byte(b0 >> 56)
-	var b1 uint64
-	b1 |= (uint64(reciever.E) & 0xF) << 4
-	b1 |= (uint64(*(*uint8)(unsafe.Pointer(&reciever.F))) & 1) << 3
-	b1 |= (uint64(reciever.G) & 0x7)
-	bytes[index+8+0] = byte(b1 >> 0)
+	b0 |= (uint64(*(*uint8)(unsafe.Pointer(&reciever.HasTimestamp))) & 1) << 7
+	b0 |= (uint64(*(*uint8)(unsafe.Pointer(&reciever.HasKind))) & 1) << 6
+	b0 |= (uint64(*(*uint8)(unsafe.Pointer(&reciever.HasValues))) & 1) << 5
+	b0 |= (uint64(reciever.Reserved) & 0x1F)
+	bytes[index+0+0] = byte(b0 >> 0)
+	return 1, nil
 }
 
-func (reciever *B) FromBytes(bytes []byte, index int) {
+func (reciever *PFlags) FromBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+1 {
+		return 0, packed.ErrShortBuffer
+	}
 	var b0 uint64
-	b0 |= uint64(bytes[index+0+7]) << 0
-	b0 |= uint64(bytes[index+0+6]) << 8
-	b0 |= uint64(bytes[index+0+5]) << 16
-	b0 |= uint64(bytes[index+0+4]) << 24
-	b0 |= uint64(bytes[index+0+3]) << 32
-	b0 |= uint64(bytes[index+0+2]) << 40
-	b0 |= uint64(bytes[index+0+1]) << 48
-	b0 |= uint64(bytes[index+0+0]) << 56
-	reciever.A = uint8(uint64((b0 >> 60) & 0xF))
-	reciever.B = uint16(uint64((b0 >> 50) & 0x3FF))
-	reciever.C = uint32(uint64((b0 >> 30) & 0xFFFFF))
-	reciever.D = int64((((b0 >> 0) & 0x3FFFFFFF) ^ (1 << 29)) - (1 << 29))
-	var b1 uint64
-	b1 |= uint64(bytes[index+8+0]) << 0
-	reciever.E = int8((((b1 >> 4) & 0xF) ^ (1 << 3)) - (1 << 3))
-	reciever.F = ((b1 >> 3) & 0x1) != 0
-	reciever.G = int8((((b1 >> 0) & 0x7) ^ (1 << 2)) - (1 << 2))
+	b0 |= uint64(bytes[index+0+0]) << 0
+	reciever.HasTimestamp = ((b0 >> 7) & 0x1) != 0
+	reciever.HasKind = ((b0 >> 6) & 0x1) != 0
+	reciever.HasValues = ((b0 >> 5) & 0x1) != 0
+	reciever.Reserved = uint8(uint64((b0 >> 0) & 0x1F))
+	return 1, nil
 }
 
-type E struct {
-	A [2]D
+// P is at least 3 bytes, big endian, with 1 byte alignment.
+//
+//	offset  size    field
+//	0       1       Flags
+//	1       1       HasInner
+//	2       0 or 4  Timestamp (when Flags.HasTimestamp)
+//	2+      0 or 1  Kind (when Flags.HasKind)
+//	2+      0 or 4  Values (when Flags.HasValues)
+//	2+      0 or 4  Inner (when HasInner)
+//	2+      1       Trailer
+type P struct {
+	Flags     PFlags
+	HasInner  bool
+	Timestamp *uint32
+	Kind      *types.ExampleEnum
+	Values    *[2]int16
+	Inner     *N
+	Trailer   uint8
 }
 
-func (reciever *E) Size() int {
-	return 36
+func (reciever *P) Size() int {
+	size := 3
+	if reciever.Timestamp != nil {
+		size += 4
+	}
+	if reciever.Kind != nil {
+		size += 1
+	}
+	if reciever.Values != nil {
+		size += 4
+	}
+	if reciever.Inner != nil {
+		size += 4
+		size += len((*reciever.Inner).Values) * 4
+		size += len((*reciever.Inner).Name)
+	}
+	return size
 }
 
-func (reciever *E) ToBytes(bytes []byte, index int) {
-	o0 := index + 0
-	for i0 := 0; i0 < 2; i0++ {
-		var b0 uint64
-		b0 |= (uint64(reciever.A[i0].A.A) & 0xF)
-		b0 |= (uint64(reciever.A[i0].A.B) & 0x3FF) << 4
-		b0 |= (uint64(reciever.A[i0].A.C) & 0xFFFFF) << 14
-		b0 |= (uint64(reciever.A[i0].A.D) & 0x3FFFFFFF) << 34
-		bytes[o0+0] = byte(b0 >> 0)
-		bytes[o0+1] = byte(b0 >> 8)
-		bytes[o0+2] = byte(b0 >> 16)
-		bytes[o0+3] = byte(b0 >> 24)
-		bytes[o0+4] = byte(b0 >> 32)
-		bytes[o0+5] = byte(b0 >> 40)
-		bytes[o0+6] = byte(b0 >> 48)
-		bytes[o0+7] = byte(b0 >> 56)
-		o0 += 8
+func (reciever *P) ToBytes(bytes []byte, index int) (int, error) {
+	reciever.Flags.HasTimestamp = reciever.Timestamp != nil
+	reciever.Flags.HasKind = reciever.Kind != nil
+	reciever.Flags.HasValues = reciever.Values != nil
+	reciever.HasInner = reciever.Inner != nil
+	if reciever.Inner != nil {
+		if uint64(len((*reciever.Inner).Values)) > 65535 {
+			return 0, &packed.FieldError{Path: "P.Inner.Values", Err: packed.ErrInvalidLength}
+		}
+		(*reciever.Inner).Count = uint16(len((*reciever.Inner).Values))
+		if uint64(len((*reciever.Inner).Name)) > 15 {
+			return 0, &packed.FieldError{Path: "P.Inner.Name", Err: packed.ErrInvalidLength}
+		}
+		(*reciever.Inner).Length = uint8(len((*reciever.Inner).Name))
+	}
+	if len(bytes) < index+reciever.Size() {
+		return 0, packed.ErrShortBuffer
+	}
+	start := index
+	var r0 int8
+	var b0 uint64
+	b0 |= (uint64(*(*uint8)(unsafe.Pointer(&reciever.Flags.HasTimestamp))) & 1) << 7
+	b0 |= (uint64(*(*uint8)(unsafe.Pointer(&reciever.Flags.HasKind))) & 1) << 6
+	b0 |= (uint64(*(*uint8)(unsafe.Pointer(&reciever.Flags.HasValues))) & 1) << 5
+	b0 |= (uint64(reciever.Flags.Reserved) & 0x1F)
+	bytes[index+0+0] = byte(b0 >> 0)
+	c7.ToBytesBigEndian(&reciever.HasInner, bytes, index+1)
+	index += 2
+	if reciever.Timestamp != nil {
+		c43.ToBytesBigEndian(&(*reciever.Timestamp), bytes, index+0)
+		index += 4
+	}
+	if reciever.Kind != nil {
+		r0 = int8((*reciever.Kind))
+		c38.ToBytesBigEndian(&r0, bytes, index+0)
+		index += 1
+	}
+	if reciever.Values != nil {
+		o2 := index + 0
+		for i0 := 0; i0 < 2; i0++ {
+			c18.ToBytesBigEndian(&(*reciever.Values)[i0], bytes, o2)
+			o2 += 2
+		}
+		index += 4
+	}
+	if reciever.Inner != nil {
+		c30.ToBytesBigEndian(&(*reciever.Inner).Count, bytes, index+0)
 		var b1 uint64
-		b1 |= (uint64(reciever.A[i0].A.E) & 0xF)
-		b1 |= (uint64(*(*uint8)(unsafe.Pointer(&reciever.A[i0].A.F))) & 1) << 4
-		b1 |= (uint64(reciever.A[i0].A.G) & 0x7) << 5
-		bytes[o0+0] = byte(b1 >> 0)
-		o0 += 1
-		var b2 uint64
-		b2 |= (uint64(reciever.A[i0].B.A) & 0xF)
-		b2 |= (uint64(reciever.A[i0].B.B) & 0x3FF) << 4
-		b2 |= (uint64(reciever.A[i0].B.C) & 0xFFFFF) << 14
-		b2 |= (uint64(reciever.A[i0].B.D) & 0x3FFFFFFF) << 34
-		bytes[o0+0] = byte(b2 >> 0)
-		bytes[o0+1] = byte(b2 >> 8)
-		bytes[o0+2] = byte(b2 >> 16)
-		bytes[o0+3] = byte(b2 >> 24)
-		bytes[o0+4] = byte(b2 >> 32)
-		bytes[o0+5] = byte(b2 >> 40)
-		bytes[o0+6] = byte(b2 >> 48)
-		bytes[o0+7] = byte(b2 >> 56)
-		o0 += 8
-		var b3 uint64
-		b3 |= (uint64(reciever.A[i0].B.E) & 0xF)
-		b3 |= (uint64(*(*uint8)(unsafe.Pointer(&reciever.A[i0].B.F))) & 1) << 4
-		b3 |= (uint64(reciever.A[i0].B.G) & 0x7) << 5
-		bytes[o0+0] = byte(b3 >> 0)
-		o0 += 1
+		b1 |= (uint64((*reciever.Inner).Length) & 0xF) << 4
+		b1 |= (uint64((*reciever.Inner).Flag) & 0xF)
+		bytes[index+2+0] = byte(b1 >> 0)
+		index += 3
+		for i0 := 0; i0 < len((*reciever.Inner).Values); i0++ {
+			c4.ToBytesBigEndian(&(*reciever.Inner).Values[i0], bytes, index)
+			index += 4
+		}
+		copy(bytes[index:], (*reciever.Inner).Name)
+		index += len((*reciever.Inner).Name)
+		c49.ToBytesBigEndian(&(*reciever.Inner).Trailer, bytes, index+0)
+		index += 1
 	}
+	c49.ToBytesBigEndian(&reciever.Trailer, bytes, index+0)
+	index += 1
+	return index - start, nil
 }
 
-func (reciever *E) FromBytes(bytes []byte, index int) {
-	o0 := index + 0
-	for i0 := 0; i0 < 2; i0++ {
-		var b0 uint64
-		b0 |= uint64(bytes[o0+0]) << 0
-		b0 |= uint64(bytes[o0+1]) << 8
-		b0 |= uint64(bytes[o0+2]) << 16
-		b0 |= uint64(bytes[o0+3]) << 24
-		b0 |= uint64(bytes[o0+4]) << 32
-		b0 |= uint64(bytes[o0+5]) << 40
-		b0 |= uint64(bytes[o0+6]) << 48
-		b0 |= uint64(bytes[o0+7]) << 56
-		reciever.A[i0].A.A = uint8(uint64((b0 >> 0) & 0xF))
-		reciever.A[i0].A.B = uint16(uint64((b0 >> 4) & 0x3FF))
-		reciever.A[i0].A.C = uint32(uint64((b0 >> 14) & 0xFFFFF))
-		reciever.A[i0].A.D = int64((((b0 >> 34) & 0x3FFFFFFF) ^ (1 << 29)) - (1 << 29))
-		o0 += 8
+func (reciever *P) FromBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+3 {
+		return 0, packed.ErrShortBuffer
+	}
+	start := index
+	var r0 int8
+	var b0 uint64
+	b0 |= uint64(bytes[index+0+0]) << 0
+	reciever.Flags.HasTimestamp = ((b0 >> 7) & 0x1) != 0
+	reciever.Flags.HasKind = ((b0 >> 6) & 0x1) != 0
+	reciever.Flags.HasValues = ((b0 >> 5) & 0x1) != 0
+	reciever.Flags.Reserved = uint8(uint64((b0 >> 0) & 0x1F))
+	c7.FromBytesBigEndian(&reciever.HasInner, bytes, index+1)
+	index += 2
+	if reciever.Flags.HasTimestamp {
+		if len(bytes)-index < 5 {
+			return 0, &packed.FieldError{Path: "P.Timestamp", Err: packed.ErrShortBuffer}
+		}
+		reciever.Timestamp = new(uint32)
+		c43.FromBytesBigEndian(&(*reciever.Timestamp), bytes, index+0)
+		index += 4
+	} else {
+		reciever.Timestamp = nil
+	}
+	if reciever.Flags.HasKind {
+		if len(bytes)-index < 2 {
+			return 0, &packed.FieldError{Path: "P.Kind", Err: packed.ErrShortBuffer}
+		}
+		reciever.Kind = new(types.ExampleEnum)
+		c38.FromBytesBigEndian(&r0, bytes, index+0)
+		(*reciever.Kind) = types.ExampleEnum(r0)
+		index += 1
+	} else {
+		reciever.Kind = nil
+	}
+	if reciever.Flags.HasValues {
+		if len(bytes)-index < 5 {
+			return 0, &packed.FieldError{Path: "P.Values", Err: packed.ErrShortBuffer}
+		}
+		reciever.Values = new([2]int16)
+		o2 := index + 0
+		for i0 := 0; i0 < 2; i0++ {
+			c18.FromBytesBigEndian(&(*reciever.Values)[i0], bytes, o2)
+			o2 += 2
+		}
+		index += 4
+	} else {
+		reciever.Values = nil
+	}
+	if reciever.HasInner {
+		if len(bytes)-index < 5 {
+			return 0, &packed.FieldError{Path: "P.Inner", Err: packed.ErrShortBuffer}
+		}
+		reciever.Inner = new(N)
+		c30.FromBytesBigEndian(&(*reciever.Inner).Count, bytes, index+0)
 		var b1 uint64
-		b1 |= uint64(bytes[o0+0]) << 0
-		reciever.A[i0].A.E = int8((((b1 >> 0) & 0xF) ^ (1 << 3)) - (1 << 3))
-		reciever.A[i0].A.F = ((b1 >> 4) & 0x1) != 0
-		reciever.A[i0].A.G = int8((((b1 >> 5) & 0x7) ^ (1 << 2)) - (1 << 2))
-		o0 += 1
-		var b2 uint64
-		b2 |= uint64(bytes[o0+0]) << 0
-		b2 |= uint64(bytes[o0+1]) << 8
-		b2 |= uint64(bytes[o0+2]) << 16
-		b2 |= uint64(bytes[o0+3]) << 24
-		b2 |= uint64(bytes[o0+4]) << 32
-		b2 |= uint64(bytes[o0+5]) << 40
-		b2 |= uint64(bytes[o0+6]) << 48
-		b2 |= uint64(bytes[o0+7]) << 56
-		reciever.A[i0].B.A = uint8(uint64((b2 >> 0) & 0xF))
-		reciever.A[i0].B.B = uint16(uint64((b2 >> 4) & 0x3FF))
-		reciever.A[i0].B.C = uint32(uint64((b2 >> 14) & 0xFFFFF))
-		reciever.A[i0].B.D = int64((((b2 >> 34) & 0x3FFFFFFF) ^ (1 << 29)) - (1 << 29))
-		o0 += 8
-		var b3 uint64
-		b3 |= uint64(bytes[o0+0]) << 0
-		reciever.A[i0].B.E = int8((((b3 >> 0) & 0xF) ^ (1 << 3)) - (1 << 3))
-		reciever.A[i0].B.F = ((b3 >> 4) & 0x1) != 0
-		reciever.A[i0].B.G = int8((((b3 >> 5) & 0x7) ^ (1 << 2)) - (1 << 2))
-		o0 += 1
+		b1 |= uint64(bytes[index+2+0]) << 0
+		(*reciever.Inner).Length = uint8(uint64((b1 >> 4) & 0xF))
+		(*reciever.Inner).Flag = uint8(uint64((b1 >> 0) & 0xF))
+		index += 3
+		if available := len(bytes) - index - 2; available < 0 || uint64(int((*reciever.Inner).Count)) > uint64(available)/4 {
+			return 0, &packed.FieldError{Path: "P.Inner.Values", Err: packed.ErrShortBuffer}
+		}
+		(*reciever.Inner).Values = make([]int32, int((*reciever.Inner).Count))
+		for i0 := 0; i0 < len((*reciever.Inner).Values); i0++ {
+			c4.FromBytesBigEndian(&(*reciever.Inner).Values[i0], bytes, index)
+			index += 4
+		}
+		if available := len(bytes) - index - 2; available < 0 || uint64(int((*reciever.Inner).Length)) > uint64(available) {
+			return 0, &packed.FieldError{Path: "P.Inner.Name", Err: packed.ErrShortBuffer}
+		}
+		(*reciever.Inner).Name = string(bytes[index : index+int((*reciever.Inner).Length)])
+		index += len((*reciever.Inner).Name)
+		c49.FromBytesBigEndian(&(*reciever.Inner).Trailer, bytes, index+0)
+		index += 1
+	} else {
+		reciever.Inner = nil
 	}
+	c49.FromBytesBigEndian(&reciever.Trailer, bytes, index+0)
+	index += 1
+	return index - start, nil
 }
 
-type F struct {
-	A [2][2][2]types.ExampleTypeInterface
+// Q is at least 7 bytes, little endian, with 1 byte alignment.
+//
+//	offset  size      field
+//	0       1         Type
+//	1       1         Kind (4 bits), Reserved (4 bits)
+//	2       variable  Payload
+//	2+      4         Fixed
+//	6+      1         Trailer
+type Q struct {
+	Type     types.ExampleEnum
+	Kind     uint8
+	Reserved uint8
+	Payload  QPayload
+	Fixed    QFixed
+	Trailer  uint8
 }
 
-func (reciever *F) Size() int {
-	return 8
+type QPayload interface {
+	Size() int
+	ToBytes(bytes []byte, index int) (int, error)
+	FromBytes(bytes []byte, index int) (int, error)
+	isQPayload()
 }
 
-func (reciever *F) ToBytes(bytes []byte, index int) {
-	o0 := index + 0
-	for i0 := 0; i0 < 2; i0++ {
-		for i1 := 0; i1 < 2; i1++ {
-			for i2 := 0; i2 < 2; i2++ {
-				reciever.A[i0][i1][i2].ToBytesLittleEndian(bytes, o0)
-				o0 += 1
-			}
-		}
+func (*QA) isQPayload() {}
+
+func (*QB) isQPayload() {}
+
+type QFixed interface {
+	Size() int
+	ToBytes(bytes []byte, index int) (int, error)
+	FromBytes(bytes []byte, index int) (int, error)
+	isQFixed()
+}
+
+func (*QA) isQFixed() {}
+
+func (*QC) isQFixed() {}
+
+func (reciever *Q) Size() int {
+	size := 7
+	if reciever.Payload != nil {
+		size += reciever.Payload.Size()
 	}
+	return size
 }
 
-func (reciever *F) FromBytes(bytes []byte, index int) {
-	o0 := index + 0
-	for i0 := 0; i0 < 2; i0++ {
-		for i1 := 0; i1 < 2; i1++ {
-			for i2 := 0; i2 < 2; i2++ {
-				reciever.A[i0][i1][i2].FromBytesLittleEndian(bytes, o0)
-				o0 += 1
-			}
-		}
+func (reciever *Q) ToBytes(bytes []byte, index int) (int, error) {
+	switch reciever.Payload.(type) {
+	case *QA:
+		reciever.Type = types.ExampleEnum(1)
+	case *QB:
+		reciever.Type = types.ExampleEnum(2)
+	default:
+		return 0, &packed.FieldError{Path: "Q.Payload", Err: packed.ErrUnknownVariant}
+	}
+	switch reciever.Fixed.(type) {
+	case *QA:
+		reciever.Kind = uint8(1)
+	case *QC:
+		reciever.Kind = uint8(3)
+	default:
+		return 0, &packed.FieldError{Path: "Q.Fixed", Err: packed.ErrUnknownVariant}
+	}
+	if len(bytes) < index+reciever.Size() {
+		return 0, packed.ErrShortBuffer
+	}
+	start := index
+	var r0 uint8
+	r0 = uint8(reciever.Type)
+	c49.ToBytesLittleEndian(&r0, bytes, index+0)
+	var b0 uint64
+	b0 |= (uint64(reciever.Kind) & 0xF)
+	b0 |= (uint64(reciever.Reserved) & 0xF) << 4
+	bytes[index+1+0] = byte(b0 >> 0)
+	index += 2
+	if n, err := reciever.Payload.ToBytes(bytes, index+0); err != nil {
+		return 0, &packed.FieldError{Path: "Q.Payload", Err: err}
+	} else {
+		index += n
 	}
+	if n, err := reciever.Fixed.ToBytes(bytes, index+0); err != nil {
+		return 0, &packed.FieldError{Path: "Q.Fixed", Err: err}
+	} else {
+		clear(bytes[index+0+n : index+4])
+	}
+	c49.ToBytesLittleEndian(&reciever.Trailer, bytes, index+4)
+	index += 5
+	return index - start, nil
 }
 
-type G struct {
-	A [2][2][2]types.ExampleRecieverType
+func (reciever *Q) FromBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+7 {
+		return 0, packed.ErrShortBuffer
+	}
+	start := index
+	var r0 uint8
+	c49.FromBytesLittleEndian(&r0, bytes, index+0)
+	reciever.Type = types.ExampleEnum(r0)
+	var b0 uint64
+	b0 |= uint64(bytes[index+1+0]) << 0
+	reciever.Kind = uint8(uint64((b0 >> 0) & 0xF))
+	reciever.Reserved = uint8(uint64((b0 >> 4) & 0xF))
+	index += 2
+	switch reciever.Type {
+	case 1:
+		reciever.Payload = new(QA)
+	case 2:
+		reciever.Payload = new(QB)
+	default:
+		return 0, &packed.FieldError{Path: "Q.Payload", Err: packed.ErrUnknownVariant}
+	}
+	if n, err := reciever.Payload.FromBytes(bytes, index+0); err != nil {
+		return 0, &packed.FieldError{Path: "Q.Payload", Err: err}
+	} else {
+		index += n
+	}
+	if len(bytes)-index < 5 {
+		return 0, &packed.FieldError{Path: "Q.Payload", Err: packed.ErrShortBuffer}
+	}
+	switch reciever.Kind {
+	case 1:
+		reciever.Fixed = new(QA)
+	case 3:
+		reciever.Fixed = new(QC)
+	default:
+		return 0, &packed.FieldError{Path: "Q.Fixed", Err: packed.ErrUnknownVariant}
+	}
+	if _, err := reciever.Fixed.FromBytes(bytes, index+0); err != nil {
+		return 0, &packed.FieldError{Path: "Q.Fixed", Err: err}
+	}
+	c49.FromBytesLittleEndian(&reciever.Trailer, bytes, index+4)
+	index += 5
+	return index - start, nil
 }
 
-func (reciever *G) Size() int {
-	return 8
+// R is 14 bytes, big endian, with 1 byte alignment.
+//
+//	offset  size  field
+//	0       1     A
+//	1       3     (padding)
+//	4       2     B
+//	6       2     (padding)
+//	8       2     C (3 bits), (reserved 5 bits), D (1 bits), (reserved 7 bits)
+//	10      4     E
+type R struct {
+	A uint8
+	B uint16
+	C uint8
+	D bool
+	E [2]RA
 }
 
-func (reciever *G) ToBytes(bytes []byte, index int) {
-	o0 := index + 0
+func (reciever *R) Size() int {
+	return 14
+}
+
+func (reciever *R) ToBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+14 {
+		return 0, packed.ErrShortBuffer
+	}
+	c49.ToBytesBigEndian(&reciever.A, bytes, index+0)
+	clear(bytes[index+1 : index+1+3])
+	c30.ToBytesBigEndian(&reciever.B, bytes, index+4)
+	for i := index + 6; i < index+6+2; i++ {
+		bytes[i] = 0xFF
+	}
+	var b0 uint64
+	b0 |= (uint64(reciever.C) & 0x7) << 13
+	b0 |= 0x1500
+	b0 |= (uint64(*(*uint8)(unsafe.Pointer(&reciever.D))) & 1) << 7
+	bytes[index+8+1] = byte(b0 >> 0)
+	bytes[index+8+0] = byte(b0 >> 8)
+	o10 := index + 10
 	for i0 := 0; i0 < 2; i0++ {
-		for i1 := 0; i1 < 2; i1++ {
-			for i2 := 0; i2 < 2; i2++ {
-				c6.ToBytesLittleEndian(&reciever.A[i0][i1][i2], bytes, o0)
-				o0 += 1
-			}
+		c49.ToBytesLittleEndian(&reciever.E[i0].A, bytes, o10)
+		o10 += 1
+		for i := o10; i < o10+1; i++ {
+			bytes[i] = 0xAA
 		}
+		o10 += 1
 	}
+	return 14, nil
 }
 
-func (reciever *G) FromBytes(bytes []byte, index int) {
-	o0 := index + 0
+func (reciever *R) FromBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+14 {
+		return 0, packed.ErrShortBuffer
+	}
+	c49.FromBytesBigEndian(&reciever.A, bytes, index+0)
+	c30.FromBytesBigEndian(&reciever.B, bytes, index+4)
+	for i := index + 6; i < index+6+2; i++ {
+		if bytes[i] != 0xFF {
+			return 0, &packed.FieldError{Path: "R", Err: packed.ErrInvalidPadding}
+		}
+	}
+	var b0 uint64
+	b0 |= uint64(bytes[index+8+1]) << 0
+	b0 |= uint64(bytes[index+8+0]) << 8
+	reciever.C = uint8(uint64((b0 >> 13) & 0x7))
+	if (b0>>8)&0x1F != 0x15 {
+		return 0, &packed.FieldError{Path: "R", Err: packed.ErrInvalidPadding}
+	}
+	reciever.D = ((b0 >> 7) & 0x1) != 0
+	o10 := index + 10
 	for i0 := 0; i0 < 2; i0++ {
-		for i1 := 0; i1 < 2; i1++ {
-			for i2 := 0; i2 < 2; i2++ {
-				c6.FromBytesLittleEndian(&reciever.A[i0][i1][i2], bytes, o0)
-				o0 += 1
+		c49.FromBytesLittleEndian(&reciever.E[i0].A, bytes, o10)
+		o10 += 1
+		for i := o10; i < o10+1; i++ {
+			if bytes[i] != 0xAA {
+				return 0, &packed.FieldError{Path: "R.E", Err: packed.ErrInvalidPadding}
 			}
 		}
+		o10 += 1
 	}
+	return 14, nil
 }
 
-type J struct {
-	A uint8
-	B types.ExampleBitsType
+// W is at least 19 bytes, big endian, with 1 byte alignment.
+//
+//	offset  size      field
+//	0       2         Length (size of struct)
+//	2       1         PayloadOffset (offset of Payload)
+//	3       2         PayloadSize (size of Payload)
+//	5       1         Count
+//	6       10        Header
+//	16      variable  Payload
+//	16+     1         Trailer
+//	17+     2         TrailerOffset (offset of Trailer)
+type W struct {
+	Length        uint16
+	PayloadOffset uint8
+	PayloadSize   uint16
+	Count         uint8
+	Header        WA
+	Payload       []byte
+	Trailer       uint8
+	TrailerOffset uint16
 }
 
-func (reciever *J) Size() int {
-	return 2
+func (reciever *W) Size() int {
+	size := 19
+	size += len(reciever.Payload)
+	return size
 }
 
-func (reciever *J) ToBytes(bytes []byte, index int) {
+func (reciever *W) ToBytes(bytes []byte, index int) (int, error) {
+	{
+		size := 19
+		size += len(reciever.Payload)
+		if uint64(size) > 65535 {
+			return 0, &packed.FieldError{Path: "W.Length", Err: packed.ErrInvalidLength}
+		}
+		reciever.Length = uint16(size)
+	}
+	reciever.PayloadOffset = 16
+	{
+		size := 0
+		size += len(reciever.Payload)
+		if uint64(size) > 65535 {
+			return 0, &packed.FieldError{Path: "W.PayloadSize", Err: packed.ErrInvalidLength}
+		}
+		reciever.PayloadSize = uint16(size)
+	}
+	{
+		count := 0
+		var zero uint16
+		for _, element := range reciever.Header.Values {
+			if element != zero {
+				count++
+			}
+		}
+		if uint64(count) > 15 {
+			return 0, &packed.FieldError{Path: "W.Header.Entries", Err: packed.ErrInvalidLength}
+		}
+		reciever.Header.Entries = uint8(count)
+	}
+	if uint64(len(reciever.Payload)) > 255 {
+		return 0, &packed.FieldError{Path: "W.Payload", Err: packed.ErrInvalidLength}
+	}
+	reciever.Count = uint8(len(reciever.Payload))
+	{
+		size := 16
+		size += len(reciever.Payload)
+		if uint64(size) > 65535 {
+			return 0, &packed.FieldError{Path: "W.TrailerOffset", Err: packed.ErrInvalidLength}
+		}
+		reciever.TrailerOffset = uint16(size)
+	}
+	if len(bytes) < index+reciever.Size() {
+		return 0, packed.ErrShortBuffer
+	}
+	start := index
+	c30.ToBytesBigEndian(&reciever.Length, bytes, index+0)
+	c49.ToBytesBigEndian(&reciever.PayloadOffset, bytes, index+2)
+	c30.ToBytesBigEndian(&reciever.PayloadSize, bytes, index+3)
+	c49.ToBytesBigEndian(&reciever.Count, bytes, index+5)
+	c49.ToBytesBigEndian(&reciever.Header.Kind, bytes, index+6)
 	var b0 uint64
-	b0 |= (uint64(reciever.A) & 0x3F)
-	b0 |= (uint64(reciever.B.Integer()) & 0x3FF) << 6
-	bytes[index+0+0] = byte(b0 >> 0)
-	bytes[index+0+1] = byte(b0 >> 8)
+	b0 |= (uint64(reciever.Header.Entries) & 0xF) << 4
+	b0 |= (uint64(reciever.Header.Flags) & 0xF)
+	bytes[index+7+0] = byte(b0 >> 0)
+	o8 := index + 8
+	for i0 := 0; i0 < 4; i0++ {
+		c30.ToBytesBigEndian(&reciever.Header.Values[i0], bytes, o8)
+		o8 += 2
+	}
+	index += 16
+	copy(bytes[index:], reciever.Payload)
+	index += len(reciever.Payload)
+	c49.ToBytesBigEndian(&reciever.Trailer, bytes, index+0)
+	c30.ToBytesBigEndian(&reciever.TrailerOffset, bytes, index+1)
+	index += 3
+	return index - start, nil
 }
 
-func (reciever *J) FromBytes(bytes []byte, index int) {
+func (reciever *W) FromBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+19 {
+		return 0, packed.ErrShortBuffer
+	}
+	start := index
+	c30.FromBytesBigEndian(&reciever.Length, bytes, index+0)
+	c49.FromBytesBigEndian(&reciever.PayloadOffset, bytes, index+2)
+	c30.FromBytesBigEndian(&reciever.PayloadSize, bytes, index+3)
+	c49.FromBytesBigEndian(&reciever.Count, bytes, index+5)
+	c49.FromBytesBigEndian(&reciever.Header.Kind, bytes, index+6)
 	var b0 uint64
-	b0 |= uint64(bytes[index+0+0]) << 0
-	b0 |= uint64(bytes[index+0+1]) << 8
-	reciever.A = uint8(uint64((b0 >> 0) & 0x3F))
-	reciever.B.Set(uint16(uint64((b0 >> 6) & 0x3FF)))
+	b0 |= uint64(bytes[index+7+0]) << 0
+	reciever.Header.Entries = uint8(uint64((b0 >> 4) & 0xF))
+	reciever.Header.Flags = uint8(uint64((b0 >> 0) & 0xF))
+	o8 := index + 8
+	for i0 := 0; i0 < 4; i0++ {
+		c30.FromBytesBigEndian(&reciever.Header.Values[i0], bytes, o8)
+		o8 += 2
+	}
+	index += 16
+	if available := len(bytes) - index - 3; available < 0 || uint64(int(reciever.Count)) > uint64(available) {
+		return 0, &packed.FieldError{Path: "W.Payload", Err: packed.ErrShortBuffer}
+	}
+	reciever.Payload = make([]byte, int(reciever.Count))
+	copy(reciever.Payload, bytes[index:])
+	index += len(reciever.Payload)
+	c49.FromBytesBigEndian(&reciever.Trailer, bytes, index+0)
+	c30.FromBytesBigEndian(&reciever.TrailerOffset, bytes, index+1)
+	{
+		size := 19
+		size += len(reciever.Payload)
+		if uint64(size) != uint64(reciever.Length) {
+			return 0, &packed.FieldError{Path: "W.Length", Err: fmt.Errorf("%w: expected %d, got %d", packed.ErrComputedMismatch, size, reciever.Length)}
+		}
+	}
+	{
+		count := 0
+		var zero uint16
+		for _, element := range reciever.Header.Values {
+			if element != zero {
+				count++
+			}
+		}
+		if uint64(count) != uint64(reciever.Header.Entries) {
+			return 0, &packed.FieldError{Path: "W.Header.Entries", Err: fmt.Errorf("%w: expected %d, got %d", packed.ErrComputedMismatch, count, reciever.Header.Entries)}
+		}
+	}
+	{
+		size := 16
+		size += len(reciever.Payload)
+		if uint64(size) != uint64(reciever.TrailerOffset) {
+			return 0, &packed.FieldError{Path: "W.TrailerOffset", Err: fmt.Errorf("%w: expected %d, got %d", packed.ErrComputedMismatch, size, reciever.TrailerOffset)}
+		}
+	}
+	index += 3
+	return index - start, nil
 }
 
-type K struct {
-	A uint8
-	B types.ExampleBitsType
+// AD is 78 bytes, big endian, with 1 byte alignment.
+//
+//	offset  size  field
+//	0       16    Hash
+//	16      16    Delta
+//	32      32    Amount
+//	64      8     Supply
+//	72      6     Balances
+type AD struct {
+	Hash     packed.U128
+	Delta    packed.I128
+	Amount   *big.Int
+	Supply   *big.Int
+	Balances [2]*big.Int
 }
 
-func (reciever *K) Size() int {
-	return 2
+func (reciever *AD) Size() int {
+	return 78
 }
 
-func (reciever *K) ToBytes(bytes []byte, index int) {
-	var b0 uint64
-	b0 |= (uint64(reciever.A) & 0x3F) << 10
-	b0 |= (uint64(reciever.B.Integer()) & 0x3FF)
-	bytes[index+0+1] = byte(b0 >> 0)
-	bytes[index+0+0] = byte(b0 >> 8)
+func (reciever *AD) ToBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+78 {
+		return 0, packed.ErrShortBuffer
+	}
+	c63.ToBytesBigEndian(&reciever.Hash, bytes, index+0)
+	c59.ToBytesLittleEndian(&reciever.Delta, bytes, index+16)
+	if err := c16.ToBytesBigEndian(&reciever.Amount, bytes, index+32); err != nil {
+		return 0, &packed.FieldError{Path: "AD.Amount", Err: err}
+	}
+	if err := c9.ToBytesLittleEndian(&reciever.Supply, bytes, index+64); err != nil {
+		return 0, &packed.FieldError{Path: "AD.Supply", Err: err}
+	}
+	o72 := index + 72
+	for i0 := 0; i0 < 2; i0++ {
+		if err := c74.ToBytesBigEndian(&reciever.Balances[i0], bytes, o72); err != nil {
+			return 0, &packed.FieldError{Path: fmt.Sprintf("AD.Balances[%d]", i0), Err: err}
+		}
+		o72 += 3
+	}
+	return 78, nil
 }
 
-func (reciever *K) FromBytes(bytes []byte, index int) {
-	var b0 uint64
-	b0 |= uint64(bytes[index+0+1]) << 0
-	b0 |= uint64(bytes[index+0+0]) << 8
-	reciever.A = uint8(uint64((b0 >> 10) & 0x3F))
-	reciever.B.Set(uint16(uint64((b0 >> 0) & 0x3FF)))
+func (reciever *AD) FromBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+78 {
+		return 0, packed.ErrShortBuffer
+	}
+	c63.FromBytesBigEndian(&reciever.Hash, bytes, index+0)
+	c59.FromBytesLittleEndian(&reciever.Delta, bytes, index+16)
+	c16.FromBytesBigEndian(&reciever.Amount, bytes, index+32)
+	c9.FromBytesLittleEndian(&reciever.Supply, bytes, index+64)
+	o72 := index + 72
+	for i0 := 0; i0 < 2; i0++ {
+		c74.FromBytesBigEndian(&reciever.Balances[i0], bytes, o72)
+		o72 += 3
+	}
+	return 78, nil
 }
 
-type C struct {
-	A uint8
-	B uint16
-	C uint32
-	D int64
-	E int8
-	F bool
-	G int8
+// A is 18 bytes, little endian, with 1 byte alignment.
+//
+//	offset  size  field
+//	0       1     A
+//	1       2     B
+//	3       4     C
+//	7       8     D
+//	15      1     E
+//	16      1     F
+//	17      1     G
+type A struct {
+	A uint8  `json:"a" xml:"a"`
+	B uint16 `json:"b" xml:"b"`
+	C uint32 `json:"c" xml:"c"`
+	D int64  `json:"d" xml:"d"`
+	E int8   `json:"e" xml:"e"`
+	F int8   `json:"f" xml:"f"`
+	G types.ExampleTypeInterface
 }
 
-func (reciever *C) Size() int {
-	return 9
+func (reciever *A) Size() int {
+	return 18
 }
 
-func (reciever *C) ToBytes(bytes []byte, index int) {
-	var b0 uint64
-	b0 |= (uint64(reciever.A) & 0xF)
-	b0 |= (uint64(reciever.B) & 0x3FF) << 4
-	b0 |= (uint64(reciever.C) & 0xFFFFF) << 14
-	b0 |= (uint64(reciever.D) & 0x3FFFFFFF) << 34
-	bytes[index+0+0] = byte(b0 >> 0)
-	bytes[index+0+1] = byte(b0 >> 8)
-	bytes[index+0+2] = byte(b0 >> 16)
-	bytes[index+0+3] = byte(b0 >> 24)
-	bytes[index+0+4] = byte(b0 >> 32)
-	bytes[index+0+5] = byte(b0 >> 40)
-	bytes[index+0+6] = byte(b0 >> 48)
-	bytes[index+0+7] = byte(b0 >> 56)
-	var b1 uint64
-	b1 |= (uint64(reciever.E) & 0xF)
-	b1 |= (uint64(*(*uint8)(unsafe.Pointer(&reciever.F))) & 1) << 4
-	b1 |= (uint64(reciever.G) & 0x7) << 5
-	bytes[index+8+0] = byte(b1 >> 0)
+func (reciever *A) ToBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+18 {
+		return 0, packed.ErrShortBuffer
+	}
+	c49.ToBytesLittleEndian(&reciever.A, bytes, index+0)
+	c30.ToBytesLittleEndian(&reciever.B, bytes, index+1)
+	c43.ToBytesLittleEndian(&reciever.C, bytes, index+3)
+	c44.ToBytesLittleEndian(&reciever.D, bytes, index+7)
+	c38.ToBytesLittleEndian(&reciever.E, bytes, index+15)
+	c38.ToBytesLittleEndian(&reciever.F, bytes, index+16)
+	reciever.G.ToBytesLittleEndian(bytes, index+17)
+	return 18, nil
 }
 
-func (reciever *C) FromBytes(bytes []byte, index int) {
-	var b0 uint64
-	b0 |= uint64(bytes[index+0+0]) << 0
-	b0 |= uint64(bytes[index+0+1]) << 8
-	b0 |= uint64(bytes[index+0+2]) << 16
-	b0 |= uint64(bytes[index+0+3]) << 24
-	b0 |= uint64(bytes[index+0+4]) << 32
-	b0 |= uint64(bytes[index+0+5]) << 40
-	b0 |= uint64(bytes[index+0+6]) << 48
-	b0 |= uint64(bytes[index+0+7]) << 56
-	reciever.A = uint8(uint64((b0 >> 0) & 0xF))
-	reciever.B = uint16(uint64((b0 >> 4) & 0x3FF))
-	reciever.C = uint32(uint64((b0 >> 14) & 0xFFFFF))
-	reciever.D = int64((((b0 >> 34) & 0x3FFFFFFF) ^ (1 << 29)) - (1 << 29))
-	var b1 uint64
-	b1 |= uint64(bytes[index+8+0]) << 0
-	reciever.E = int8((((b1 >> 0) & 0xF) ^ (1 << 3)) - (1 << 3))
-	reciever.F = ((b1 >> 4) & 0x1) != 0
-	reciever.G = int8((((b1 >> 5) & 0x7) ^ (1 << 2)) - (1 << 2))
+func (reciever *A) FromBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+18 {
+		return 0, packed.ErrShortBuffer
+	}
+	c49.FromBytesLittleEndian(&reciever.A, bytes, index+0)
+	c30.FromBytesLittleEndian(&reciever.B, bytes, index+1)
+	c43.FromBytesLittleEndian(&reciever.C, bytes, index+3)
+	c44.FromBytesLittleEndian(&reciever.D, bytes, index+7)
+	c38.FromBytesLittleEndian(&reciever.E, bytes, index+15)
+	c38.FromBytesLittleEndian(&reciever.F, bytes, index+16)
+	reciever.G.FromBytesLittleEndian(bytes, index+17)
+	return 18, nil
 }
 
+// D is 18 bytes, little endian, with 1 byte alignment.
+//
+//	offset  size  field
+//	0       9     A
+//	9       9     B
 type D struct {
 	A B
 	B C
@@ -477,7 +3968,10 @@ func (reciever *D) Size() int {
 	return 18
 }
 
-func (reciever *D) ToBytes(bytes []byte, index int) {
+func (reciever *D) ToBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+18 {
+		return 0, packed.ErrShortBuffer
+	}
 	var b0 uint64
 	b0 |= (uint64(reciever.A.A) & 0xF)
 	b0 |= (uint64(reciever.A.B) & 0x3FF) << 4
@@ -514,9 +4008,13 @@ func (reciever *D) ToBytes(bytes []byte, index int) {
 	b3 |= (uint64(*(*uint8)(unsafe.Pointer(&reciever.B.F))) & 1) << 4
 	b3 |= (uint64(reciever.B.G) & 0x7) << 5
 	bytes[index+17+0] = byte(b3 >> 0)
+	return 18, nil
 }
 
-func (reciever *D) FromBytes(bytes []byte, index int) {
+func (reciever *D) FromBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+18 {
+		return 0, packed.ErrShortBuffer
+	}
 	var b0 uint64
 	b0 |= uint64(bytes[index+0+0]) << 0
 	b0 |= uint64(bytes[index+0+1]) << 8
@@ -553,81 +4051,181 @@ func (reciever *D) FromBytes(bytes []byte, index int) {
 	reciever.B.E = int8((((b3 >> 0) & 0xF) ^ (1 << 3)) - (1 << 3))
 	reciever.B.F = ((b3 >> 4) & 0x1) != 0
 	reciever.B.G = int8((((b3 >> 5) & 0x7) ^ (1 << 2)) - (1 << 2))
+	return 18, nil
 }
 
-type H struct {
-	A types.ExampleEnum
+// E is 36 bytes, big endian, with 1 byte alignment.
+//
+//	offset  size  field
+//	0       36    A
+type E struct {
+	A [2]D
 }
 
-func (reciever *H) Size() int {
-	return 2
+func (reciever *E) Size() int {
+	return 36
 }
 
-func (reciever *H) ToBytes(bytes []byte, index int) {
-	var r0 int16
-	r0 = int16(reciever.A)
-	c7.ToBytesBigEndian(&r0, bytes, index+0)
+func (reciever *E) ToBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+36 {
+		return 0, packed.ErrShortBuffer
+	}
+	o0 := index + 0
+	for i0 := 0; i0 < 2; i0++ {
+		var b0 uint64
+		b0 |= (uint64(reciever.A[i0].A.A) & 0xF)
+		b0 |= (uint64(reciever.A[i0].A.B) & 0x3FF) << 4
+		b0 |= (uint64(reciever.A[i0].A.C) & 0xFFFFF) << 14
+		b0 |= (uint64(reciever.A[i0].A.D) & 0x3FFFFFFF) << 34
+		bytes[o0+0] = byte(b0 >> 0)
+		bytes[o0+1] = byte(b0 >> 8)
+		bytes[o0+2] = byte(b0 >> 16)
+		bytes[o0+3] = byte(b0 >> 24)
+		bytes[o0+4] = byte(b0 >> 32)
+		bytes[o0+5] = byte(b0 >> 40)
+		bytes[o0+6] = byte(b0 >> 48)
+		bytes[o0+7] = byte(b0 >> 56)
+		o0 += 8
+		var b1 uint64
+		b1 |= (uint64(reciever.A[i0].A.E) & 0xF)
+		b1 |= (uint64(*(*uint8)(unsafe.Pointer(&reciever.A[i0].A.F))) & 1) << 4
+		b1 |= (uint64(reciever.A[i0].A.G) & 0x7) << 5
+		bytes[o0+0] = byte(b1 >> 0)
+		o0 += 1
+		var b2 uint64
+		b2 |= (uint64(reciever.A[i0].B.A) & 0xF)
+		b2 |= (uint64(reciever.A[i0].B.B) & 0x3FF) << 4
+		b2 |= (uint64(reciever.A[i0].B.C) & 0xFFFFF) << 14
+		b2 |= (uint64(reciever.A[i0].B.D) & 0x3FFFFFFF) << 34
+		bytes[o0+0] = byte(b2 >> 0)
+		bytes[o0+1] = byte(b2 >> 8)
+		bytes[o0+2] = byte(b2 >> 16)
+		bytes[o0+3] = byte(b2 >> 24)
+		bytes[o0+4] = byte(b2 >> 32)
+		bytes[o0+5] = byte(b2 >> 40)
+		bytes[o0+6] = byte(b2 >> 48)
+		bytes[o0+7] = byte(b2 >> 56)
+		o0 += 8
+		var b3 uint64
+		b3 |= (uint64(reciever.A[i0].B.E) & 0xF)
+		b3 |= (uint64(*(*uint8)(unsafe.Pointer(&reciever.A[i0].B.F))) & 1) << 4
+		b3 |= (uint64(reciever.A[i0].B.G) & 0x7) << 5
+		bytes[o0+0] = byte(b3 >> 0)
+		o0 += 1
+	}
+	return 36, nil
 }
 
-func (reciever *H) FromBytes(bytes []byte, index int) {
-	var r0 int16
-	c7.FromBytesBigEndian(&r0, bytes, index+0)
-	reciever.A = types.ExampleEnum(r0)
+func (reciever *E) FromBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+36 {
+		return 0, packed.ErrShortBuffer
+	}
+	o0 := index + 0
+	for i0 := 0; i0 < 2; i0++ {
+		var b0 uint64
+		b0 |= uint64(bytes[o0+0]) << 0
+		b0 |= uint64(bytes[o0+1]) << 8
+		b0 |= uint64(bytes[o0+2]) << 16
+		b0 |= uint64(bytes[o0+3]) << 24
+		b0 |= uint64(bytes[o0+4]) << 32
+		b0 |= uint64(bytes[o0+5]) << 40
+		b0 |= uint64(bytes[o0+6]) << 48
+		b0 |= uint64(bytes[o0+7]) << 56
+		reciever.A[i0].A.A = uint8(uint64((b0 >> 0) & 0xF))
+		reciever.A[i0].A.B = uint16(uint64((b0 >> 4) & 0x3FF))
+		reciever.A[i0].A.C = uint32(uint64((b0 >> 14) & 0xFFFFF))
+		reciever.A[i0].A.D = int64((((b0 >> 34) & 0x3FFFFFFF) ^ (1 << 29)) - (1 << 29))
+		o0 += 8
+		var b1 uint64
+		b1 |= uint64(bytes[o0+0]) << 0
+		reciever.A[i0].A.E = int8((((b1 >> 0) & 0xF) ^ (1 << 3)) - (1 << 3))
+		reciever.A[i0].A.F = ((b1 >> 4) & 0x1) != 0
+		reciever.A[i0].A.G = int8((((b1 >> 5) & 0x7) ^ (1 << 2)) - (1 << 2))
+		o0 += 1
+		var b2 uint64
+		b2 |= uint64(bytes[o0+0]) << 0
+		b2 |= uint64(bytes[o0+1]) << 8
+		b2 |= uint64(bytes[o0+2]) << 16
+		b2 |= uint64(bytes[o0+3]) << 24
+		b2 |= uint64(bytes[o0+4]) << 32
+		b2 |= uint64(bytes[o0+5]) << 40
+		b2 |= uint64(bytes[o0+6]) << 48
+		b2 |= uint64(bytes[o0+7]) << 56
+		reciever.A[i0].B.A = uint8(uint64((b2 >> 0) & 0xF))
+		reciever.A[i0].B.B = uint16(uint64((b2 >> 4) & 0x3FF))
+		reciever.A[i0].B.C = uint32(uint64((b2 >> 14) & 0xFFFFF))
+		reciever.A[i0].B.D = int64((((b2 >> 34) & 0x3FFFFFFF) ^ (1 << 29)) - (1 << 29))
+		o0 += 8
+		var b3 uint64
+		b3 |= uint64(bytes[o0+0]) << 0
+		reciever.A[i0].B.E = int8((((b3 >> 0) & 0xF) ^ (1 << 3)) - (1 << 3))
+		reciever.A[i0].B.F = ((b3 >> 4) & 0x1) != 0
+		reciever.A[i0].B.G = int8((((b3 >> 5) & 0x7) ^ (1 << 2)) - (1 << 2))
+		o0 += 1
+	}
+	return 36, nil
 }
 
-type I struct {
-	A types.ExampleEnum
-	B [2]types.ExampleEnum
-	C [2]H
-	D types.ExampleEnumString
+// M is 8 bytes, little endian, with 1 byte alignment.
+//
+//	offset  size  field
+//	0       4     A
+//	4       4     B
+type M struct {
+	A [2]L
+	B [2]K
 }
 
-func (reciever *I) Size() int {
-	return 11
+func (reciever *M) Size() int {
+	return 8
 }
 
-func (reciever *I) ToBytes(bytes []byte, index int) {
-	var r0 int32
-	var r1 int8
-	var r2 int16
-	var r3 string
-	r0 = int32(reciever.A)
-	c8.ToBytesLittleEndian(&r0, bytes, index+0)
-	o4 := index + 4
+func (reciever *M) ToBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+8 {
+		return 0, packed.ErrShortBuffer
+	}
+	o0 := index + 0
 	for i0 := 0; i0 < 2; i0++ {
-		r1 = int8(reciever.B[i0])
-		c2.ToBytesLittleEndian(&r1, bytes, o4)
-		o4 += 1
+		var b0 uint64
+		b0 |= (uint64(reciever.A[i0].A) & 0xF)
+		b0 |= (uint64(c31.Integer(&reciever.A[i0].B)) & 0x3FF) << 4
+		bytes[o0+0] = byte(b0 >> 0)
+		bytes[o0+1] = byte(b0 >> 8)
+		o0 += 2
 	}
-	o6 := index + 6
+	o4 := index + 4
 	for i0 := 0; i0 < 2; i0++ {
-		r2 = int16(reciever.C[i0].A)
-		c7.ToBytesBigEndian(&r2, bytes, o6)
-		o6 += 2
+		var b0 uint64
+		b0 |= (uint64(reciever.B[i0].A) & 0x3F) << 10
+		b0 |= (uint64(reciever.B[i0].B.Integer()) & 0x3FF)
+		bytes[o4+1] = byte(b0 >> 0)
+		bytes[o4+0] = byte(b0 >> 8)
+		o4 += 2
 	}
-	r3 = string(reciever.D)
-	c9.ToBytesLittleEndian(&r3, bytes, index+10)
+	return 8, nil
 }
 
-func (reciever *I) FromBytes(bytes []byte, index int) {
-	var r0 int32
-	var r1 int8
-	var r2 int16
-	var r3 string
-	c8.FromBytesLittleEndian(&r0, bytes, index+0)
-	reciever.A = types.ExampleEnum(r0)
-	o4 := index + 4
+func (reciever *M) FromBytes(bytes []byte, index int) (int, error) {
+	if len(bytes) < index+8 {
+		return 0, packed.ErrShortBuffer
+	}
+	o0 := index + 0
 	for i0 := 0; i0 < 2; i0++ {
-		c2.FromBytesLittleEndian(&r1, bytes, o4)
-		reciever.B[i0] = types.ExampleEnum(r1)
-		o4 += 1
+		var b0 uint64
+		b0 |= uint64(bytes[o0+0]) << 0
+		b0 |= uint64(bytes[o0+1]) << 8
+		reciever.A[i0].A = uint8(uint64((b0 >> 0) & 0xF))
+		c31.Set(&reciever.A[i0].B, uint16(uint64((b0>>4)&0x3FF)))
+		o0 += 2
 	}
-	o6 := index + 6
+	o4 := index + 4
 	for i0 := 0; i0 < 2; i0++ {
-		c7.FromBytesBigEndian(&r2, bytes, o6)
-		reciever.C[i0].A = types.ExampleEnum(r2)
-		o6 += 2
+		var b0 uint64
+		b0 |= uint64(bytes[o4+1]) << 0
+		b0 |= uint64(bytes[o4+0]) << 8
+		reciever.B[i0].A = uint8(uint64((b0 >> 10) & 0x3F))
+		reciever.B[i0].B.Set(uint16(uint64((b0 >> 0) & 0x3FF)))
+		o4 += 2
 	}
-	c9.FromBytesLittleEndian(&r3, bytes, index+10)
-	reciever.D = types.ExampleEnumString(r3)
+	return 8, nil
 }
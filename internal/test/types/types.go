@@ -8,6 +8,10 @@ const (
 	ExampleEnumValueC
 )
 
+func (e ExampleEnum) IsValid() bool {
+	return e >= ExampleEnumValueA && e <= ExampleEnumValueC
+}
+
 type ExampleEnumString string
 
 const (
@@ -0,0 +1,241 @@
+package packed
+
+import (
+	"fmt"
+	"net"
+	"net/netip"
+)
+
+var (
+	IPv4            = IPv4Converter{}
+	IPv6            = IPv6Converter{}
+	IPv4AddrPort    = IPv4AddrPortConverter{}
+	MACAddress      = MACConverter{}
+	HardwareAddress = HardwareAddrConverter{}
+)
+
+func ipv4Bytes(value netip.Addr) ([4]byte, error) {
+
+	if !value.IsValid() {
+		return [4]byte{}, nil
+	}
+
+	value = value.Unmap()
+
+	if !value.Is4() {
+		return [4]byte{}, fmt.Errorf("%w: %s is not an ipv4 address", ErrInvalidValue, value)
+	}
+
+	return value.As4(), nil
+}
+
+type IPv4Converter struct{}
+
+func (IPv4Converter) Size() int { return 4 }
+
+func (IPv4Converter) ToBytesLittleEndian(value *netip.Addr, bytes []byte, index int) error {
+
+	address, err := ipv4Bytes(*value)
+
+	if err != nil {
+		return err
+	}
+
+	copy(bytes[index:index+4], address[:])
+	return nil
+}
+
+func (IPv4Converter) FromBytesLittleEndian(reciever *netip.Addr, bytes []byte, index int) {
+	*reciever = netip.AddrFrom4([4]byte(bytes[index : index+4]))
+}
+
+func (c IPv4Converter) ToBytesBigEndian(value *netip.Addr, bytes []byte, index int) error {
+	return c.ToBytesLittleEndian(value, bytes, index)
+}
+
+func (c IPv4Converter) FromBytesBigEndian(reciever *netip.Addr, bytes []byte, index int) {
+	c.FromBytesLittleEndian(reciever, bytes, index)
+}
+
+type IPv6Converter struct {
+	OnlyIPv6 bool `packed_hash_field:"only_ipv6"`
+}
+
+func (c IPv6Converter) Strict() IPv6Converter {
+	c.OnlyIPv6 = true
+	return c
+}
+
+func (c *IPv6Converter) InitializeConverterFields() map[string]string {
+	return map[string]string{
+		"OnlyIPv6": fmt.Sprintf("%v", c.OnlyIPv6),
+	}
+}
+
+func (c *IPv6Converter) Size() int { return 16 }
+
+func (c *IPv6Converter) ToBytesLittleEndian(value *netip.Addr, bytes []byte, index int) error {
+
+	if !value.IsValid() {
+
+		if c.OnlyIPv6 {
+			return fmt.Errorf("%w: the zero address is not an ipv6 address", ErrInvalidValue)
+		}
+
+		clear(bytes[index : index+16])
+		return nil
+	}
+
+	if c.OnlyIPv6 && value.Unmap().Is4() {
+		return fmt.Errorf("%w: %s is not an ipv6 address", ErrInvalidValue, *value)
+	}
+
+	address := value.As16()
+	copy(bytes[index:index+16], address[:])
+	return nil
+}
+
+func (c *IPv6Converter) FromBytesLittleEndian(reciever *netip.Addr, bytes []byte, index int) error {
+
+	address := netip.AddrFrom16([16]byte(bytes[index : index+16]))
+
+	if c.OnlyIPv6 && address.Is4In6() {
+		return fmt.Errorf("%w: %s is an ipv4-mapped address", ErrInvalidValue, address)
+	}
+
+	*reciever = address
+	return nil
+}
+
+func (c *IPv6Converter) ToBytesBigEndian(value *netip.Addr, bytes []byte, index int) error {
+	return c.ToBytesLittleEndian(value, bytes, index)
+}
+
+func (c *IPv6Converter) FromBytesBigEndian(reciever *netip.Addr, bytes []byte, index int) error {
+	return c.FromBytesLittleEndian(reciever, bytes, index)
+}
+
+type IPv4AddrPortConverter struct{}
+
+func (IPv4AddrPortConverter) Size() int { return 6 }
+
+func (IPv4AddrPortConverter) ToBytesLittleEndian(value *netip.AddrPort, bytes []byte, index int) error {
+
+	address, err := ipv4Bytes(value.Addr())
+
+	if err != nil {
+		return err
+	}
+
+	copy(bytes[index:index+4], address[:])
+	bytes[index+4] = byte(value.Port())
+	bytes[index+5] = byte(value.Port() >> 8)
+	return nil
+}
+
+func (IPv4AddrPortConverter) FromBytesLittleEndian(reciever *netip.AddrPort, bytes []byte, index int) {
+	port := uint16(bytes[index+4]) | uint16(bytes[index+5])<<8
+	*reciever = netip.AddrPortFrom(netip.AddrFrom4([4]byte(bytes[index:index+4])), port)
+}
+
+func (IPv4AddrPortConverter) ToBytesBigEndian(value *netip.AddrPort, bytes []byte, index int) error {
+
+	address, err := ipv4Bytes(value.Addr())
+
+	if err != nil {
+		return err
+	}
+
+	copy(bytes[index:index+4], address[:])
+	bytes[index+4] = byte(value.Port() >> 8)
+	bytes[index+5] = byte(value.Port())
+	return nil
+}
+
+func (IPv4AddrPortConverter) FromBytesBigEndian(reciever *netip.AddrPort, bytes []byte, index int) {
+	port := uint16(bytes[index+4])<<8 | uint16(bytes[index+5])
+	*reciever = netip.AddrPortFrom(netip.AddrFrom4([4]byte(bytes[index:index+4])), port)
+}
+
+type MAC [6]byte
+
+func ParseMAC(value string) (MAC, error) {
+
+	address, err := net.ParseMAC(value)
+
+	if err != nil || len(address) != 6 {
+		return MAC{}, fmt.Errorf("%w: %q is not a valid mac address", ErrInvalidValue, value)
+	}
+
+	return MAC(address), nil
+}
+
+func (m MAC) String() string {
+	return net.HardwareAddr(m[:]).String()
+}
+
+func (m MAC) MarshalText() ([]byte, error) {
+	return []byte(m.String()), nil
+}
+
+func (m *MAC) UnmarshalText(text []byte) error {
+
+	address, err := ParseMAC(string(text))
+
+	if err != nil {
+		return err
+	}
+
+	*m = address
+	return nil
+}
+
+type MACConverter struct{}
+
+func (MACConverter) Size() int { return 6 }
+
+func (MACConverter) ToBytesLittleEndian(value *MAC, bytes []byte, index int) {
+	copy(bytes[index:index+6], value[:])
+}
+
+func (MACConverter) FromBytesLittleEndian(reciever *MAC, bytes []byte, index int) {
+	copy(reciever[:], bytes[index:index+6])
+}
+
+func (c MACConverter) ToBytesBigEndian(value *MAC, bytes []byte, index int) {
+	c.ToBytesLittleEndian(value, bytes, index)
+}
+
+func (c MACConverter) FromBytesBigEndian(reciever *MAC, bytes []byte, index int) {
+	c.FromBytesLittleEndian(reciever, bytes, index)
+}
+
+type HardwareAddrConverter struct{}
+
+func (HardwareAddrConverter) Size() int { return 6 }
+
+func (HardwareAddrConverter) ToBytesLittleEndian(value *net.HardwareAddr, bytes []byte, index int) error {
+
+	switch len(*value) {
+	case 0:
+		clear(bytes[index : index+6])
+		return nil
+	case 6:
+		copy(bytes[index:index+6], *value)
+		return nil
+	}
+
+	return fmt.Errorf("%w: hardware address %s is not 6 bytes", ErrInvalidValue, *value)
+}
+
+func (HardwareAddrConverter) FromBytesLittleEndian(reciever *net.HardwareAddr, bytes []byte, index int) {
+	*reciever = net.HardwareAddr(append([]byte(nil), bytes[index:index+6]...))
+}
+
+func (c HardwareAddrConverter) ToBytesBigEndian(value *net.HardwareAddr, bytes []byte, index int) error {
+	return c.ToBytesLittleEndian(value, bytes, index)
+}
+
+func (c HardwareAddrConverter) FromBytesBigEndian(reciever *net.HardwareAddr, bytes []byte, index int) {
+	c.FromBytesLittleEndian(reciever, bytes, index)
+}
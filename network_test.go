@@ -0,0 +1,169 @@
+package packed
+
+import (
+	"errors"
+	"net"
+	"net/netip"
+	"reflect"
+	"testing"
+)
+
+func TestIPv4(t *testing.T) {
+
+	address := netip.MustParseAddr("192.168.1.20")
+	bytes := make([]byte, 5)
+
+	if err := IPv4.ToBytesLittleEndian(&address, bytes, 1); err != nil || !reflect.DeepEqual(bytes[1:], []byte{192, 168, 1, 20}) {
+		t.Errorf("little endian: got %x (%v)", bytes[1:], err)
+	}
+
+	var result netip.Addr
+	IPv4.FromBytesBigEndian(&result, bytes, 1)
+
+	if result != address {
+		t.Errorf("big endian: expected %s, got %s", address, result)
+	}
+
+	mapped := netip.MustParseAddr("::ffff:10.0.0.1")
+
+	if err := IPv4.ToBytesBigEndian(&mapped, bytes, 1); err != nil || !reflect.DeepEqual(bytes[1:], []byte{10, 0, 0, 1}) {
+		t.Errorf("mapped: got %x (%v)", bytes[1:], err)
+	}
+
+	invalid := netip.MustParseAddr("2001:db8::1")
+
+	if err := IPv4.ToBytesBigEndian(&invalid, bytes, 1); !errors.Is(err, ErrInvalidValue) {
+		t.Errorf("ipv6: expected invalid value, got %v", err)
+	}
+
+	var zero netip.Addr
+
+	if err := IPv4.ToBytesBigEndian(&zero, bytes, 1); err != nil || !reflect.DeepEqual(bytes[1:], []byte{0, 0, 0, 0}) {
+		t.Errorf("zero: got %x (%v)", bytes[1:], err)
+	}
+}
+
+func TestIPv6(t *testing.T) {
+
+	address := netip.MustParseAddr("2001:db8::1")
+	bytes := make([]byte, 16)
+
+	if err := IPv6.ToBytesLittleEndian(&address, bytes, 0); err != nil || bytes[0] != 0x20 || bytes[1] != 0x01 || bytes[15] != 0x01 {
+		t.Errorf("little endian: got %x (%v)", bytes, err)
+	}
+
+	var result netip.Addr
+
+	if err := IPv6.FromBytesBigEndian(&result, bytes, 0); err != nil || result != address {
+		t.Errorf("big endian: expected %s, got %s (%v)", address, result, err)
+	}
+
+	mapped := netip.MustParseAddr("10.0.0.1")
+
+	if err := IPv6.ToBytesBigEndian(&mapped, bytes, 0); err != nil {
+		t.Fatalf("mapped: unexpected error %v", err)
+	}
+
+	if err := IPv6.FromBytesBigEndian(&result, bytes, 0); err != nil || result != netip.MustParseAddr("::ffff:10.0.0.1") {
+		t.Errorf("mapped: got %s (%v)", result, err)
+	}
+
+	strict := IPv6.Strict()
+
+	if err := strict.FromBytesBigEndian(&result, bytes, 0); !errors.Is(err, ErrInvalidValue) {
+		t.Errorf("strict decode: expected invalid value, got %v", err)
+	}
+
+	if err := strict.ToBytesBigEndian(&mapped, bytes, 0); !errors.Is(err, ErrInvalidValue) {
+		t.Errorf("strict encode: expected invalid value, got %v", err)
+	}
+
+	var zero netip.Addr
+
+	if err := IPv6.ToBytesBigEndian(&zero, bytes, 0); err != nil || !reflect.DeepEqual(bytes, make([]byte, 16)) {
+		t.Errorf("zero: got %x (%v)", bytes, err)
+	}
+
+	if err := IPv6.FromBytesBigEndian(&result, bytes, 0); err != nil || result != netip.IPv6Unspecified() {
+		t.Errorf("zero: expected the unspecified address, got %s (%v)", result, err)
+	}
+
+	if err := strict.ToBytesBigEndian(&zero, bytes, 0); !errors.Is(err, ErrInvalidValue) {
+		t.Errorf("strict zero: expected invalid value, got %v", err)
+	}
+}
+
+func TestIPv4AddrPort(t *testing.T) {
+
+	value := netip.MustParseAddrPort("10.1.2.3:8080")
+	bytes := make([]byte, 6)
+	var result netip.AddrPort
+
+	if err := IPv4AddrPort.ToBytesLittleEndian(&value, bytes, 0); err != nil || !reflect.DeepEqual(bytes, []byte{10, 1, 2, 3, 0x90, 0x1F}) {
+		t.Errorf("little endian: got %x (%v)", bytes, err)
+	}
+
+	if IPv4AddrPort.FromBytesLittleEndian(&result, bytes, 0); result != value {
+		t.Errorf("little endian: expected %s, got %s", value, result)
+	}
+
+	if err := IPv4AddrPort.ToBytesBigEndian(&value, bytes, 0); err != nil || !reflect.DeepEqual(bytes, []byte{10, 1, 2, 3, 0x1F, 0x90}) {
+		t.Errorf("big endian: got %x (%v)", bytes, err)
+	}
+
+	if IPv4AddrPort.FromBytesBigEndian(&result, bytes, 0); result != value {
+		t.Errorf("big endian: expected %s, got %s", value, result)
+	}
+
+	invalid := netip.MustParseAddrPort("[::1]:80")
+
+	if err := IPv4AddrPort.ToBytesBigEndian(&invalid, bytes, 0); !errors.Is(err, ErrInvalidValue) {
+		t.Errorf("ipv6: expected invalid value, got %v", err)
+	}
+}
+
+func TestMAC(t *testing.T) {
+
+	mac, err := ParseMAC("00:1a:2b:3c:4d:5e")
+
+	if err != nil || mac != (MAC{0x00, 0x1A, 0x2B, 0x3C, 0x4D, 0x5E}) || mac.String() != "00:1a:2b:3c:4d:5e" {
+		t.Errorf("parse: got %s (%v)", mac, err)
+	}
+
+	if _, err := ParseMAC("00:00:5e:00:53:01:02:03"); !errors.Is(err, ErrInvalidValue) {
+		t.Errorf("parse eui-64: expected invalid value, got %v", err)
+	}
+
+	var unmarshalled MAC
+
+	if err := unmarshalled.UnmarshalText([]byte("00-1A-2B-3C-4D-5E")); err != nil || unmarshalled != mac {
+		t.Errorf("unmarshal: got %s (%v)", unmarshalled, err)
+	}
+
+	bytes := make([]byte, 6)
+	var result MAC
+
+	MACAddress.ToBytesBigEndian(&mac, bytes, 0)
+	MACAddress.FromBytesLittleEndian(&result, bytes, 0)
+
+	if result != mac || !reflect.DeepEqual(bytes, mac[:]) {
+		t.Errorf("converter: got %x", bytes)
+	}
+
+	hardware := net.HardwareAddr(mac[:])
+	var hardwareResult net.HardwareAddr
+
+	if err := HardwareAddress.ToBytesLittleEndian(&hardware, bytes, 0); err != nil {
+		t.Fatalf("hardware: unexpected error %v", err)
+	}
+
+	if HardwareAddress.FromBytesBigEndian(&hardwareResult, bytes, 0); !reflect.DeepEqual(hardwareResult, hardware) {
+		t.Errorf("hardware: expected %s, got %s", hardware, hardwareResult)
+	}
+
+	long := net.HardwareAddr{1, 2, 3, 4, 5, 6, 7, 8}
+
+	if err := HardwareAddress.ToBytesLittleEndian(&long, bytes, 0); !errors.Is(err, ErrInvalidValue) {
+		t.Errorf("hardware: expected invalid value, got %v", err)
+	}
+}
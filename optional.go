@@ -0,0 +1,101 @@
+package packed
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+)
+
+func resolvePresenceFlag(properties []packedProperty, path string) {
+
+	property, ok := findProperty(properties, path)
+
+	if !ok {
+		panic(fmt.Sprintf("presence flag %s does not exist before the optional field", path))
+	}
+
+	switch property.kind {
+
+	case kindConverter:
+		if property.recieverType.Kind() == reflect.Bool {
+			return
+		}
+
+	case kindBitField:
+		if property.packed.(packedBitField).bitFieldKind == bitFieldKindBoolean {
+			return
+		}
+	}
+
+	panic(fmt.Sprintf("presence flag %s is not a boolean", path))
+}
+
+func (p *packedProperty) writeOptionalSize(buffer *bytes.Buffer, reciever string) {
+
+	fmt.Fprintf(buffer, "if %s != nil {\n", reciever)
+	fmt.Fprintf(buffer, "size += %d\n", p.size)
+
+	if structure, ok := p.packed.(packedStruct); ok && structure.variable {
+		for _, child := range structure.properties {
+			child.writeSize(buffer, "(*"+reciever+")")
+		}
+	}
+
+	if p.kind == kindVariable {
+		p.writeVariableSize(buffer, "(*"+reciever+")")
+	}
+
+	fmt.Fprintf(buffer, "}\n")
+}
+
+func (p *packedProperty) writeOptionalPrepare(buffer *bytes.Buffer, structure *packedStruct, recieverPrefix string, reciever string) {
+
+	fmt.Fprintf(buffer, "%s.%s = %s != nil\n", recieverPrefix, p.when, reciever)
+
+	child, ok := p.packed.(packedStruct)
+
+	if !ok || !child.prepared {
+		return
+	}
+
+	fmt.Fprintf(buffer, "if %s != nil {\n", reciever)
+
+	for _, property := range child.properties {
+		property.writePrepare(buffer, structure, "(*"+reciever+")")
+	}
+
+	fmt.Fprintf(buffer, "}\n")
+}
+
+func (p *packedProperty) writeOptional(buffer *bytes.Buffer, structure *packedStruct, functionName, recieverPrefix, reciever string, offset *propertyOffset) {
+
+	offset.flush(buffer)
+
+	switch functionName {
+
+	case "ToBytes":
+		fmt.Fprintf(buffer, "if %s != nil {\n", reciever)
+
+	case "FromBytes":
+		fmt.Fprintf(buffer, "if %s.%s {\n", recieverPrefix, p.when)
+		fmt.Fprintf(buffer, "if len(bytes)-index < %d {\n", p.size+offset.remaining(structure))
+		fmt.Fprintf(buffer, "return 0, &packed.FieldError{Path: %q, Err: packed.ErrShortBuffer}\n", fieldPath(structure, reciever))
+		fmt.Fprintf(buffer, "}\n")
+		fmt.Fprintf(buffer, "%s = new(%s)\n", reciever, p.goType())
+
+	default:
+		panic("invalid function name")
+	}
+
+	inner := &propertyOffset{minimum: offset.minimum, reserved: offset.reserved + p.size}
+
+	p.writeValue(buffer, structure, functionName, recieverPrefix, "(*"+reciever+")", inner)
+	inner.flush(buffer)
+
+	if functionName == "FromBytes" {
+		fmt.Fprintf(buffer, "} else {\n")
+		fmt.Fprintf(buffer, "%s = nil\n", reciever)
+	}
+
+	fmt.Fprintf(buffer, "}\n")
+}
@@ -26,6 +26,10 @@ type converterHash struct {
 
 var (
 	structs                 = map[string]packedStruct{}
+	enums                   = map[string]*packedEnum{}
+	flagSets                = map[string]*packedFlags{}
+	fixedStrings            = map[string]*packedFixedString{}
+	unions                  = map[string]bool{}
 	converters              = map[string]converterHash{}
 	imported                = map[string]bool{}
 	converterIdentifiers    = map[string]string{}
@@ -48,6 +52,13 @@ type ConverterInterface[Reciever any] interface {
 	FromBytesBigEndian(reciever *Reciever, bytes []byte, index int)
 }
 
+type VariableConverterInterface[Reciever any] interface {
+	Size() int
+	SizeOf(reciever *Reciever) int
+	ToBytes(reciever *Reciever, bytes []byte, index int) (int, error)
+	FromBytes(reciever *Reciever, bytes []byte, index int) (int, error)
+}
+
 type InitializeConverterFieldInterface interface {
 	InitializeConverterFields() map[string]string
 }
@@ -117,6 +128,25 @@ func getConverterName(hash string) string {
 	return name
 }
 
+func converterLiteral(converter converterHash) string {
+
+	if !converter.hasInitializeConverterField {
+		return fmt.Sprintf("&%s{}", converter.reflection)
+	}
+
+	literal := &bytes.Buffer{}
+
+	fmt.Fprintf(literal, "&%s{", converter.reflection)
+
+	for name, value := range converter.instance.(InitializeConverterFieldInterface).InitializeConverterFields() {
+		fmt.Fprintf(literal, " %s: %v,", name, value)
+	}
+
+	fmt.Fprintf(literal, "}")
+
+	return literal.String()
+}
+
 func Load(structures ...packedStruct) {
 	for _, structure := range structures {
 		structs[structure.name] = structure
@@ -127,6 +157,8 @@ func Generate(outputFile string, packageName string) {
 
 	buffer := &bytes.Buffer{}
 
+	imported[reflect.TypeOf(packedStruct{}).PkgPath()] = true
+
 	fmt.Fprintf(buffer, "// Code generated by github.com/0-mqix/packed; DO NOT EDIT.\n\n")
 	fmt.Fprintf(buffer, "package %s\n\n", packageName)
 	fmt.Fprintf(buffer, "import (\n")
@@ -139,12 +171,6 @@ func Generate(outputFile string, packageName string) {
 
 	for _, converter := range converters {
 
-		var initialize InitializeConverterFieldInterface
-
-		if converter.hasInitializeConverterField {
-			initialize = converter.instance.(InitializeConverterFieldInterface)
-		}
-
 		fmt.Fprintf(buffer, "// %s", converter.reflection)
 
 		for name, field := range converter.fields {
@@ -156,27 +182,31 @@ func Generate(outputFile string, packageName string) {
 			fmt.Fprintf(buffer, " %s: %v", name, field.value)
 		}
 
-		fmt.Fprintf(buffer, "\n%s = &%s", getConverterName(converter.hash), converter.reflection)
-
-		if converter.hasInitializeConverterField {
-			fmt.Fprintf(buffer, "{")
-
-			fields := initialize.InitializeConverterFields()
+		fmt.Fprintf(buffer, "\n%s = %s\n", getConverterName(converter.hash), converterLiteral(converter))
+	}
 
-			for name, value := range fields {
-				fmt.Fprintf(buffer, " %s: %v,", name, value)
-			}
+	fmt.Fprintf(buffer, ")\n")
 
-			fmt.Fprintf(buffer, "}")
+	for _, enum := range enums {
+		buffer.Write(enum.definition())
+		fmt.Fprintf(buffer, "\n")
+	}
 
-		} else {
-			fmt.Fprintf(buffer, "{}")
-		}
+	for _, flags := range flagSets {
+		buffer.Write(flags.definition())
+		fmt.Fprintf(buffer, "\n")
+	}
 
+	for _, fixedString := range fixedStrings {
+		buffer.Write(fixedString.definition())
 		fmt.Fprintf(buffer, "\n")
 	}
 
-	fmt.Fprintf(buffer, ")\n")
+	variants := map[string]bool{}
+
+	for _, packed := range structs {
+		packed.validatedVariants(variants)
+	}
 
 	for _, packed := range structs {
 		buffer.Write(packed.structDefinition())
@@ -187,6 +217,11 @@ func Generate(outputFile string, packageName string) {
 		fmt.Fprintf(buffer, "\n")
 		buffer.Write(packed.conversionDefinition("FromBytes"))
 		fmt.Fprintf(buffer, "\n")
+
+		if packed.validated() || variants[packed.name] {
+			buffer.Write(packed.validateDefinition())
+			fmt.Fprintf(buffer, "\n")
+		}
 	}
 
 	result, err := imports.Process("", buffer.Bytes(), &imports.Options{
@@ -0,0 +1,145 @@
+package packed
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+)
+
+type packedPadding struct {
+	size   int
+	fill   uint64
+	verify bool
+}
+
+func (p packedPadding) Size() int { return p.size }
+
+func Padding(size int, options ...fieldOption) packedProperty {
+
+	if size < 1 {
+		panic("padding size must be at least one byte")
+	}
+
+	property := packedProperty{
+		size:   size,
+		packed: packedPadding{size: size},
+		kind:   kindPadding,
+	}
+
+	for _, option := range options {
+		option(&property)
+	}
+
+	return property
+}
+
+func ReservedBits(bits int, options ...fieldOption) packedProperty {
+
+	if bits < 1 || bits > 64 {
+		panic("reserved bits must be between 1 and 64")
+	}
+
+	property := packedProperty{
+		packed: packedBitField{
+			bitSize:      bits,
+			reflection:   reflect.TypeOf(uint64(0)),
+			bitFieldKind: bitFieldKindReserved,
+		},
+		kind: kindBitField,
+	}
+
+	for _, option := range options {
+		option(&property)
+	}
+
+	return property
+}
+
+func Fill(value uint64) fieldOption {
+	return func(definition *packedProperty) {
+
+		switch packed := definition.packed.(type) {
+
+		case packedPadding:
+			if value > 0xFF {
+				panic("padding fill value must fit in a byte")
+			}
+
+			packed.fill = value
+			definition.packed = packed
+
+		case packedBitField:
+			if packed.bitFieldKind != bitFieldKindReserved {
+				panic("fill can only be set for padding and reserved bits")
+			}
+
+			if packed.bitSize < 64 && value>>packed.bitSize != 0 {
+				panic(fmt.Sprintf("fill value 0x%X does not fit in %d reserved bits", value, packed.bitSize))
+			}
+
+			packed.fill = value
+			definition.packed = packed
+
+		default:
+			panic("fill can only be set for padding and reserved bits")
+		}
+	}
+}
+
+func Verify() fieldOption {
+	return func(definition *packedProperty) {
+
+		if definition.computed.kind != computedNone {
+			definition.computed.verify = true
+			return
+		}
+
+		switch packed := definition.packed.(type) {
+
+		case packedPadding:
+			packed.verify = true
+			definition.packed = packed
+
+		case packedBitField:
+			if packed.bitFieldKind != bitFieldKindReserved {
+				panic("verify can only be set for padding, reserved bits and computed fields")
+			}
+
+			packed.verify = true
+			definition.packed = packed
+
+		default:
+			panic("verify can only be set for padding, reserved bits and computed fields")
+		}
+	}
+}
+
+func (p packedPadding) write(buffer *bytes.Buffer, structure *packedStruct, recieverPrefix string, functionName string, offset string) {
+
+	switch functionName {
+
+	case "ToBytes":
+		if p.fill == 0 {
+			fmt.Fprintf(buffer, "clear(bytes[%s : %s+%d])\n", offset, offset, p.size)
+			return
+		}
+
+		fmt.Fprintf(buffer, "for i := %s; i < %s+%d; i++ {\n", offset, offset, p.size)
+		fmt.Fprintf(buffer, "bytes[i] = 0x%X\n", p.fill)
+		fmt.Fprintf(buffer, "}\n")
+
+	case "FromBytes":
+		if !p.verify {
+			return
+		}
+
+		fmt.Fprintf(buffer, "for i := %s; i < %s+%d; i++ {\n", offset, offset, p.size)
+		fmt.Fprintf(buffer, "if bytes[i] != 0x%X {\n", p.fill)
+		fmt.Fprintf(buffer, "return 0, &packed.FieldError{Path: %q, Err: packed.ErrInvalidPadding}\n", fieldPath(structure, recieverPrefix))
+		fmt.Fprintf(buffer, "}\n")
+		fmt.Fprintf(buffer, "}\n")
+
+	default:
+		panic("invalid function name")
+	}
+}
@@ -0,0 +1,235 @@
+package packed
+
+import (
+	"fmt"
+	"image/color"
+	"strconv"
+)
+
+var (
+	RGB565   = PixelFormat("R5G6B5")
+	BGR565   = PixelFormat("B5G6R5")
+	RGBA5551 = PixelFormat("R5G5B5A1")
+	ARGB1555 = PixelFormat("A1R5G5B5")
+	RGBA4444 = PixelFormat("R4G4B4A4")
+	ARGB4444 = PixelFormat("A4R4G4B4")
+	RGB888   = PixelFormat("R8G8B8")
+	BGR888   = PixelFormat("B8G8R8")
+	RGBA8888 = PixelFormat("R8G8B8A8")
+	ARGB8888 = PixelFormat("A8R8G8B8")
+	BGRA8888 = PixelFormat("B8G8R8A8")
+	ABGR8888 = PixelFormat("A8B8G8R8")
+	XRGB8888 = PixelFormat("X8R8G8B8")
+
+	Grayscale4 = Grayscale(4)
+	Grayscale8 = Grayscale(8)
+)
+
+func expandChannel(value uint64, bits int) uint8 {
+	maximum := lowBits(bits)
+	return uint8((value*255 + maximum/2) / maximum)
+}
+
+func reduceChannel(value uint8, bits int) uint64 {
+	maximum := lowBits(bits)
+	return (uint64(value)*maximum + 127) / 255
+}
+
+func PixelFormat(layout string) PixelConverter {
+
+	var pixel PixelConverter
+
+	seen := map[byte]bool{}
+	type channel struct {
+		name byte
+		bits int
+	}
+	channels := []channel{}
+
+	for i := 0; i < len(layout); {
+
+		name := layout[i]
+		end := i + 1
+
+		for end < len(layout) && layout[end] >= '0' && layout[end] <= '9' {
+			end++
+		}
+
+		bits, err := strconv.Atoi(layout[i+1 : end])
+
+		if err != nil || bits < 1 {
+			panic(fmt.Sprintf("pixel layout %s has an invalid width for channel %c", layout, name))
+		}
+
+		if name != 'X' && (seen[name] || bits > 8) {
+			panic(fmt.Sprintf("pixel layout %s has an invalid channel %c%d", layout, name, bits))
+		}
+
+		seen[name] = true
+		channels = append(channels, channel{name: name, bits: bits})
+		pixel.Bits += bits
+		i = end
+	}
+
+	if !seen['R'] || !seen['G'] || !seen['B'] {
+		panic(fmt.Sprintf("pixel layout %s must have red, green and blue channels", layout))
+	}
+
+	if pixel.Bits > 64 {
+		panic(fmt.Sprintf("pixel layout %s is wider than 64 bits", layout))
+	}
+
+	shift := pixel.Bits
+
+	for _, channel := range channels {
+
+		shift -= channel.bits
+
+		switch channel.name {
+		case 'R':
+			pixel.RedBits, pixel.RedShift = channel.bits, shift
+		case 'G':
+			pixel.GreenBits, pixel.GreenShift = channel.bits, shift
+		case 'B':
+			pixel.BlueBits, pixel.BlueShift = channel.bits, shift
+		case 'A':
+			pixel.AlphaBits, pixel.AlphaShift = channel.bits, shift
+		case 'X':
+		default:
+			panic(fmt.Sprintf("pixel layout %s has an unknown channel %c", layout, channel.name))
+		}
+	}
+
+	return pixel
+}
+
+type PixelConverter struct {
+	Bits       int `packed_hash_field:"bits"`
+	RedBits    int `packed_hash_field:"red_bits"`
+	RedShift   int `packed_hash_field:"red_shift"`
+	GreenBits  int `packed_hash_field:"green_bits"`
+	GreenShift int `packed_hash_field:"green_shift"`
+	BlueBits   int `packed_hash_field:"blue_bits"`
+	BlueShift  int `packed_hash_field:"blue_shift"`
+	AlphaBits  int `packed_hash_field:"alpha_bits"`
+	AlphaShift int `packed_hash_field:"alpha_shift"`
+}
+
+func (p *PixelConverter) InitializeConverterFields() map[string]string {
+	return map[string]string{
+		"Bits":       fmt.Sprintf("%d", p.Bits),
+		"RedBits":    fmt.Sprintf("%d", p.RedBits),
+		"RedShift":   fmt.Sprintf("%d", p.RedShift),
+		"GreenBits":  fmt.Sprintf("%d", p.GreenBits),
+		"GreenShift": fmt.Sprintf("%d", p.GreenShift),
+		"BlueBits":   fmt.Sprintf("%d", p.BlueBits),
+		"BlueShift":  fmt.Sprintf("%d", p.BlueShift),
+		"AlphaBits":  fmt.Sprintf("%d", p.AlphaBits),
+		"AlphaShift": fmt.Sprintf("%d", p.AlphaShift),
+	}
+}
+
+func (p *PixelConverter) Size() int { return (p.Bits + 7) / 8 }
+
+func (p *PixelConverter) bitWidth() int { return p.Bits }
+
+func (p *PixelConverter) matchesMemory(littleEndian bool) bool {
+
+	if p.Bits != 32 || p.RedBits != 8 || p.GreenBits != 8 || p.BlueBits != 8 || p.AlphaBits != 8 {
+		return false
+	}
+
+	if littleEndian {
+		return p.RedShift == 0 && p.GreenShift == 8 && p.BlueShift == 16 && p.AlphaShift == 24
+	}
+
+	return p.RedShift == 24 && p.GreenShift == 16 && p.BlueShift == 8 && p.AlphaShift == 0
+}
+
+func (p *PixelConverter) Integer(value *color.RGBA) uint64 {
+
+	integer := reduceChannel(value.R, p.RedBits)<<p.RedShift |
+		reduceChannel(value.G, p.GreenBits)<<p.GreenShift |
+		reduceChannel(value.B, p.BlueBits)<<p.BlueShift
+
+	if p.AlphaBits > 0 {
+		integer |= reduceChannel(value.A, p.AlphaBits) << p.AlphaShift
+	}
+
+	return integer
+}
+
+func (p *PixelConverter) Set(reciever *color.RGBA, integer uint64) {
+
+	reciever.R = expandChannel(integer>>p.RedShift&lowBits(p.RedBits), p.RedBits)
+	reciever.G = expandChannel(integer>>p.GreenShift&lowBits(p.GreenBits), p.GreenBits)
+	reciever.B = expandChannel(integer>>p.BlueShift&lowBits(p.BlueBits), p.BlueBits)
+	reciever.A = 0xFF
+
+	if p.AlphaBits > 0 {
+		reciever.A = expandChannel(integer>>p.AlphaShift&lowBits(p.AlphaBits), p.AlphaBits)
+	}
+}
+
+func (p *PixelConverter) ToBytesLittleEndian(value *color.RGBA, bytes []byte, index int) {
+	putInteger(p.Integer(value), p.Size(), bytes, index, true)
+}
+
+func (p *PixelConverter) FromBytesLittleEndian(reciever *color.RGBA, bytes []byte, index int) {
+	p.Set(reciever, getInteger(p.Size(), bytes, index, true))
+}
+
+func (p *PixelConverter) ToBytesBigEndian(value *color.RGBA, bytes []byte, index int) {
+	putInteger(p.Integer(value), p.Size(), bytes, index, false)
+}
+
+func (p *PixelConverter) FromBytesBigEndian(reciever *color.RGBA, bytes []byte, index int) {
+	p.Set(reciever, getInteger(p.Size(), bytes, index, false))
+}
+
+func Grayscale(bits int) GrayscaleConverter {
+
+	if bits < 1 || bits > 8 {
+		panic(fmt.Sprintf("grayscale bits must be between 1 and 8, got %d", bits))
+	}
+
+	return GrayscaleConverter{Bits: bits}
+}
+
+type GrayscaleConverter struct {
+	Bits int `packed_hash_field:"bits"`
+}
+
+func (g *GrayscaleConverter) InitializeConverterFields() map[string]string {
+	return map[string]string{
+		"Bits": fmt.Sprintf("%d", g.Bits),
+	}
+}
+
+func (g *GrayscaleConverter) Size() int { return 1 }
+
+func (g *GrayscaleConverter) bitWidth() int { return g.Bits }
+
+func (g *GrayscaleConverter) Integer(value *color.Gray) uint64 {
+	return reduceChannel(value.Y, g.Bits)
+}
+
+func (g *GrayscaleConverter) Set(reciever *color.Gray, integer uint64) {
+	reciever.Y = expandChannel(integer&lowBits(g.Bits), g.Bits)
+}
+
+func (g *GrayscaleConverter) ToBytesLittleEndian(value *color.Gray, bytes []byte, index int) {
+	bytes[index] = byte(g.Integer(value))
+}
+
+func (g *GrayscaleConverter) FromBytesLittleEndian(reciever *color.Gray, bytes []byte, index int) {
+	g.Set(reciever, uint64(bytes[index]))
+}
+
+func (g *GrayscaleConverter) ToBytesBigEndian(value *color.Gray, bytes []byte, index int) {
+	g.ToBytesLittleEndian(value, bytes, index)
+}
+
+func (g *GrayscaleConverter) FromBytesBigEndian(reciever *color.Gray, bytes []byte, index int) {
+	g.FromBytesLittleEndian(reciever, bytes, index)
+}
@@ -0,0 +1,149 @@
+package packed
+
+import (
+	"image/color"
+	"reflect"
+	"testing"
+)
+
+func TestPixelConverter(t *testing.T) {
+
+	orange := color.RGBA{R: 0xFF, G: 0x80, B: 0x00, A: 0xFF}
+	translucent := color.RGBA{R: 0x11, G: 0x22, B: 0x33, A: 0x44}
+
+	tests := []struct {
+		name      string
+		converter PixelConverter
+		value     color.RGBA
+		bigEndian []byte
+		decoded   color.RGBA
+	}{
+		{"rgb565", RGB565, orange, []byte{0xFC, 0x00}, color.RGBA{R: 0xFF, G: 0x82, B: 0x00, A: 0xFF}},
+		{"bgr565", BGR565, orange, []byte{0x04, 0x1F}, color.RGBA{R: 0xFF, G: 0x82, B: 0x00, A: 0xFF}},
+		{"rgba5551", RGBA5551, translucent, []byte{0x11, 0x0C}, color.RGBA{R: 0x10, G: 0x21, B: 0x31, A: 0x00}},
+		{"argb1555", ARGB1555, orange, []byte{0xFE, 0x00}, color.RGBA{R: 0xFF, G: 0x84, B: 0x00, A: 0xFF}},
+		{"rgba4444", RGBA4444, translucent, []byte{0x12, 0x34}, translucent},
+		{"argb4444", ARGB4444, translucent, []byte{0x41, 0x23}, translucent},
+		{"rgb888", RGB888, translucent, []byte{0x11, 0x22, 0x33}, color.RGBA{R: 0x11, G: 0x22, B: 0x33, A: 0xFF}},
+		{"bgr888", BGR888, translucent, []byte{0x33, 0x22, 0x11}, color.RGBA{R: 0x11, G: 0x22, B: 0x33, A: 0xFF}},
+		{"rgba8888", RGBA8888, translucent, []byte{0x11, 0x22, 0x33, 0x44}, translucent},
+		{"argb8888", ARGB8888, translucent, []byte{0x44, 0x11, 0x22, 0x33}, translucent},
+		{"bgra8888", BGRA8888, translucent, []byte{0x33, 0x22, 0x11, 0x44}, translucent},
+		{"abgr8888", ABGR8888, translucent, []byte{0x44, 0x33, 0x22, 0x11}, translucent},
+		{"xrgb8888", XRGB8888, translucent, []byte{0x00, 0x11, 0x22, 0x33}, color.RGBA{R: 0x11, G: 0x22, B: 0x33, A: 0xFF}},
+	}
+
+	for _, test := range tests {
+
+		bytes := make([]byte, test.converter.Size())
+		var result color.RGBA
+
+		test.converter.ToBytesBigEndian(&test.value, bytes, 0)
+		test.converter.FromBytesBigEndian(&result, bytes, 0)
+
+		if !reflect.DeepEqual(bytes, test.bigEndian) {
+			t.Errorf("%s: expected %x, got %x", test.name, test.bigEndian, bytes)
+		}
+
+		if result != test.decoded {
+			t.Errorf("%s: expected %v, got %v", test.name, test.decoded, result)
+		}
+
+		test.converter.ToBytesLittleEndian(&test.value, bytes, 0)
+
+		for i := range bytes {
+			if bytes[i] != test.bigEndian[len(bytes)-1-i] {
+				t.Errorf("%s little endian: expected reversed %x, got %x", test.name, test.bigEndian, bytes)
+				break
+			}
+		}
+
+		test.converter.FromBytesLittleEndian(&result, bytes, 0)
+
+		if result != test.decoded {
+			t.Errorf("%s little endian: expected %v, got %v", test.name, test.decoded, result)
+		}
+	}
+}
+
+func TestPixelChannels(t *testing.T) {
+
+	for bits := 1; bits <= 8; bits++ {
+		for value := uint64(0); value <= lowBits(bits); value++ {
+			if reduced := reduceChannel(expandChannel(value, bits), bits); reduced != value {
+				t.Errorf("%d bits: %d expanded to %d and reduced to %d", bits, value, expandChannel(value, bits), reduced)
+			}
+		}
+	}
+
+	if expandChannel(31, 5) != 0xFF || expandChannel(0, 5) != 0 || expandChannel(1, 1) != 0xFF {
+		t.Errorf("channel extremes should map to 0 and 255")
+	}
+}
+
+func TestPixelMemoryLayout(t *testing.T) {
+
+	if !RGBA8888.matchesMemory(false) || !ABGR8888.matchesMemory(true) {
+		t.Errorf("rgba bytes in memory order should match the memory layout")
+	}
+
+	if RGBA8888.matchesMemory(true) || ARGB8888.matchesMemory(false) || RGB565.matchesMemory(false) {
+		t.Errorf("other layouts should not match the memory layout")
+	}
+}
+
+func TestGrayscaleConverter(t *testing.T) {
+
+	bytes := make([]byte, 1)
+	value := color.Gray{Y: 0x99}
+	var result color.Gray
+
+	Grayscale4.ToBytesBigEndian(&value, bytes, 0)
+	Grayscale4.FromBytesLittleEndian(&result, bytes, 0)
+
+	if bytes[0] != 0x09 || result.Y != 0x99 {
+		t.Errorf("grayscale4: got %x and %v", bytes, result)
+	}
+
+	Grayscale8.ToBytesLittleEndian(&value, bytes, 0)
+
+	if bytes[0] != 0x99 {
+		t.Errorf("grayscale8: got %x", bytes)
+	}
+}
+
+func TestPixelFormatInvalid(t *testing.T) {
+
+	for _, layout := range []string{"", "R5G6", "R9G8B8", "R5R5G5B5", "R5G6B5Q1", "RG6B5", "X41R8G8B8"} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%q: expected a panic", layout)
+				}
+			}()
+			PixelFormat(layout)
+		}()
+	}
+}
+
+func TestPixelBitFieldWidths(t *testing.T) {
+
+	tests := map[string]func(){
+		"pixel":     func() { Bits[uint64](12, RGBA4444) },
+		"grayscale": func() { Bits[uint64](2, Grayscale4) },
+	}
+
+	for name, test := range tests {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s: expected a panic", name)
+				}
+			}()
+			test()
+		}()
+	}
+
+	Bits[uint64](16, RGBA4444)
+	Bits[uint64](4, Grayscale4)
+}
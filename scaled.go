@@ -0,0 +1,184 @@
+package packed
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+
+	"golang.org/x/exp/constraints"
+)
+
+func Scaled(raw any, factor, offset float64) any {
+
+	if factor == 0 || math.IsNaN(factor) || math.IsInf(factor, 0) {
+		panic(fmt.Sprintf("scale factor must be finite and non-zero, got %v", factor))
+	}
+
+	if math.IsNaN(offset) || math.IsInf(offset, 0) {
+		panic(fmt.Sprintf("scale offset must be finite, got %v", offset))
+	}
+
+	if field, ok := raw.(packedBitField); ok {
+
+		if field.bitFieldKind != bitFieldKindInteger || field.flags != nil {
+			panic("only plain integer bit fields can be scaled")
+		}
+
+		converter := toPointer(scaledConverter(field.reflection, nil, field.bitSize, factor, offset))
+		hash := createConverterHash(converter)
+
+		if _, exists := converters[hash.hash]; !exists {
+			converters[hash.hash] = hash
+		}
+
+		field.bitFieldKind = bitFieldKindBitsConverter
+		field.bitsTargetReflection = reflect.TypeFor[*float64]()
+		field.converter = &hash
+
+		return field
+	}
+
+	converter := toPointer(raw)
+	reciever, ok := implementsConverterInterface(converter)
+
+	if !ok {
+		panic("not a valid converter")
+	}
+
+	bits := converter.(interface{ Size() int }).Size() * 8
+
+	if reciever.Bits() < bits {
+		bits = reciever.Bits()
+	}
+
+	return scaledConverter(reciever, converter, bits, factor, offset)
+}
+
+func scaledConverter(reciever reflect.Type, raw any, bits int, factor, offset float64) any {
+
+	switch reciever {
+	case reflect.TypeFor[int8]():
+		return newScaledConverter[int8](raw, bits, factor, offset)
+	case reflect.TypeFor[int16]():
+		return newScaledConverter[int16](raw, bits, factor, offset)
+	case reflect.TypeFor[int32]():
+		return newScaledConverter[int32](raw, bits, factor, offset)
+	case reflect.TypeFor[int64]():
+		return newScaledConverter[int64](raw, bits, factor, offset)
+	case reflect.TypeFor[uint8]():
+		return newScaledConverter[uint8](raw, bits, factor, offset)
+	case reflect.TypeFor[uint16]():
+		return newScaledConverter[uint16](raw, bits, factor, offset)
+	case reflect.TypeFor[uint32]():
+		return newScaledConverter[uint32](raw, bits, factor, offset)
+	case reflect.TypeFor[uint64]():
+		return newScaledConverter[uint64](raw, bits, factor, offset)
+	}
+
+	panic(fmt.Sprintf("scaled values must be backed by a sized integer, got %s", reciever))
+}
+
+func newScaledConverter[R constraints.Integer](raw any, bits int, factor, offset float64) ScaledConverter[R] {
+
+	converter := ScaledConverter[R]{Bits: bits, Factor: factor, Offset: offset}
+
+	if raw == nil {
+		return converter
+	}
+
+	integer, ok := raw.(ConverterInterface[R])
+
+	if !ok {
+		panic("scaled values must be backed by a converter that does not return errors")
+	}
+
+	converter.Raw = integer
+	converter.RawHash = createConverterHash(raw).hash
+
+	return converter
+}
+
+type ScaledConverter[R constraints.Integer] struct {
+	Raw     ConverterInterface[R]
+	RawHash string  `packed_hash_field:"raw"`
+	Bits    int     `packed_hash_field:"bits"`
+	Factor  float64 `packed_hash_field:"factor"`
+	Offset  float64 `packed_hash_field:"offset"`
+}
+
+func (c *ScaledConverter[R]) InitializeConverterFields() map[string]string {
+
+	fields := map[string]string{
+		"RawHash": fmt.Sprintf("%q", c.RawHash),
+		"Bits":    fmt.Sprintf("%v", c.Bits),
+		"Factor":  fmt.Sprintf("%v", c.Factor),
+		"Offset":  fmt.Sprintf("%v", c.Offset),
+	}
+
+	if c.Raw != nil {
+		fields["Raw"] = converterLiteral(createConverterHash(c.Raw))
+	}
+
+	return fields
+}
+
+func (c *ScaledConverter[R]) Size() int { return c.Raw.Size() }
+
+func (c *ScaledConverter[R]) Integer(value *float64) R {
+
+	raw := math.Round((*value - c.Offset) / c.Factor)
+
+	if math.IsNaN(raw) {
+		raw = 0
+	}
+
+	var zero R
+
+	if ^zero < 0 {
+		limit := math.Ldexp(1, c.Bits-1)
+
+		switch {
+		case raw >= limit:
+			return R(int64(uint64(1)<<(c.Bits-1) - 1))
+		case raw < -limit:
+			return R(int64(-1) << (c.Bits - 1))
+		}
+
+		return R(int64(raw))
+	}
+
+	switch {
+	case raw <= 0:
+		return 0
+	case raw >= math.Ldexp(1, c.Bits):
+		return R(uint64(math.MaxUint64) >> (64 - c.Bits))
+	}
+
+	return R(uint64(raw))
+}
+
+func (c *ScaledConverter[R]) Set(reciever *float64, integer R) {
+	*reciever = float64(integer)*c.Factor + c.Offset
+}
+
+func (c *ScaledConverter[R]) ToBytesLittleEndian(value *float64, bytes []byte, index int) {
+	raw := c.Integer(value)
+	c.Raw.ToBytesLittleEndian(&raw, bytes, index)
+}
+
+func (c *ScaledConverter[R]) FromBytesLittleEndian(reciever *float64, bytes []byte, index int) {
+	var raw R
+	c.Raw.FromBytesLittleEndian(&raw, bytes, index)
+	c.Set(reciever, raw)
+}
+
+func (c *ScaledConverter[R]) ToBytesBigEndian(value *float64, bytes []byte, index int) {
+	raw := c.Integer(value)
+	c.Raw.ToBytesBigEndian(&raw, bytes, index)
+}
+
+func (c *ScaledConverter[R]) FromBytesBigEndian(reciever *float64, bytes []byte, index int) {
+	var raw R
+	c.Raw.FromBytesBigEndian(&raw, bytes, index)
+	c.Set(reciever, raw)
+}
@@ -0,0 +1,131 @@
+package packed
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestScaledConverter(t *testing.T) {
+
+	tests := []struct {
+		name     string
+		scaled   any
+		value    float64
+		expected []byte
+		decoded  float64
+	}{
+		{"uint8", Scaled(Uint8, 0.5, -40), 25.2, []byte{130}, 25},
+		{"uint8 clamp high", Scaled(Uint8, 0.5, -40), 1000, []byte{255}, 87.5},
+		{"uint8 clamp low", Scaled(Uint8, 0.5, -40), -100, []byte{0}, -40},
+		{"uint8 nan", Scaled(Uint8, 0.5, 0), math.NaN(), []byte{0}, 0},
+		{"int16", Scaled(Int16, 0.1, 0), -12.34, []byte{0xFF, 0x85}, -12.3},
+		{"int16 clamp high", Scaled(Int16, 0.1, 0), 5000, []byte{0x7F, 0xFF}, 3276.7},
+		{"int16 clamp low", Scaled(Int16, 0.1, 0), -5000, []byte{0x80, 0x00}, -3276.8},
+		{"int24", Scaled(Int(3), 0.01, 0), 1e6, []byte{0x7F, 0xFF, 0xFF}, 83886.07},
+		{"uint64 clamp", Scaled(Uint64, 1, 0), 1e30, []byte{0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF}, math.MaxUint64},
+		{"int64 clamp", Scaled(Int64, 1, 0), -1e30, []byte{0x80, 0, 0, 0, 0, 0, 0, 0}, math.MinInt64},
+	}
+
+	for _, test := range tests {
+
+		converter := toPointer(test.scaled).(ConverterInterface[float64])
+		bytes := make([]byte, converter.Size())
+		var result float64
+
+		converter.ToBytesBigEndian(&test.value, bytes, 0)
+		converter.FromBytesBigEndian(&result, bytes, 0)
+
+		if !reflect.DeepEqual(bytes, test.expected) {
+			t.Errorf("%s: expected %x, got %x", test.name, test.expected, bytes)
+		}
+
+		if math.Abs(result-test.decoded) > 1e-9*math.Max(1, math.Abs(test.decoded)) {
+			t.Errorf("%s: expected %v, got %v", test.name, test.decoded, result)
+		}
+
+		converter.ToBytesLittleEndian(&test.value, bytes, 0)
+		converter.FromBytesLittleEndian(&result, bytes, 0)
+
+		if math.Abs(result-test.decoded) > 1e-9*math.Max(1, math.Abs(test.decoded)) {
+			t.Errorf("%s little endian: expected %v, got %v", test.name, test.decoded, result)
+		}
+	}
+}
+
+func TestScaledBits(t *testing.T) {
+
+	field := Scaled(Bits[uint16](12), 0.1, -40).(packedBitField)
+
+	if field.bitFieldKind != bitFieldKindBitsConverter || field.bitSize != 12 {
+		t.Fatalf("expected a 12 bit converter field, got %+v", field)
+	}
+
+	converter := field.converter.instance.(*ScaledConverter[uint16])
+
+	for _, test := range []struct {
+		value   float64
+		integer uint16
+	}{
+		{-40, 0},
+		{25.04, 650},
+		{369.5, 4095},
+		{-50, 0},
+	} {
+		if integer := converter.Integer(&test.value); integer != test.integer {
+			t.Errorf("%v: expected %d, got %d", test.value, test.integer, integer)
+		}
+	}
+
+	signed := Scaled(Bits[int8](4), 2, 0).(packedBitField).converter.instance.(*ScaledConverter[int8])
+	value := 100.0
+
+	if integer := signed.Integer(&value); integer != 7 {
+		t.Errorf("signed: expected 7, got %d", integer)
+	}
+
+	value = -100
+
+	if integer := signed.Integer(&value); integer != -8 {
+		t.Errorf("signed: expected -8, got %d", integer)
+	}
+
+	signed.Set(&value, -3)
+
+	if value != -6 {
+		t.Errorf("signed: expected -6, got %v", value)
+	}
+}
+
+func TestScaledHash(t *testing.T) {
+
+	if createConverterHash(toPointer(Scaled(Uint16, 0.1, 0))).hash == createConverterHash(toPointer(Scaled(Uint(2), 0.1, 0))).hash {
+		t.Errorf("scaled converters with different raw converters should not share a hash")
+	}
+
+	if createConverterHash(toPointer(Scaled(Uint16, 0.1, 0))).hash != createConverterHash(toPointer(Scaled(Uint16, 0.1, 0))).hash {
+		t.Errorf("equal scaled converters should share a hash")
+	}
+}
+
+func TestScaledInvalid(t *testing.T) {
+
+	tests := map[string]func(){
+		"zero factor":    func() { Scaled(Uint8, 0, 0) },
+		"float":          func() { Scaled(Float32, 1, 0) },
+		"fallible":       func() { Scaled(BCD(2), 1, 0) },
+		"bit":            func() { Scaled(Bit, 1, 0) },
+		"bits converter": func() { Scaled(Bits[uint64](8, BCD(2)), 1, 0) },
+	}
+
+	for name, test := range tests {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s: expected a panic", name)
+				}
+			}()
+			test()
+		}()
+	}
+}
@@ -0,0 +1,273 @@
+package packed
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"strings"
+)
+
+type sliceKind int
+
+const (
+	sliceKindElements sliceKind = iota
+	sliceKindBytes
+	sliceKindString
+)
+
+type packedSlice struct {
+	lengthField    string
+	lengthType     reflect.Type
+	lengthTypeName string
+	lengthMaximum  uint64
+	sliceKind      sliceKind
+	Element        any
+	ElementKind    kind
+	ElementSize    int
+	recieverType   string
+}
+
+func (s packedSlice) Size() int { return 0 }
+
+func Slice(countField string, elementType any) packedSlice {
+
+	kind, elementType, elementSize := validateElementType(elementType)
+
+	return packedSlice{
+		lengthField:  countField,
+		sliceKind:    sliceKindElements,
+		Element:      elementType,
+		ElementKind:  kind,
+		ElementSize:  elementSize,
+		recieverType: "[]" + getArrayRecieverType(elementType),
+	}
+}
+
+func Bytes(lengthField string) packedSlice {
+	return packedSlice{
+		lengthField:  lengthField,
+		sliceKind:    sliceKindBytes,
+		ElementSize:  1,
+		recieverType: "[]byte",
+	}
+}
+
+func VarString(lengthField string) packedSlice {
+	return packedSlice{
+		lengthField:  lengthField,
+		sliceKind:    sliceKindString,
+		ElementSize:  1,
+		recieverType: "string",
+	}
+}
+
+func integerMaximum(reflection reflect.Type, bits int) uint64 {
+
+	switch reflection.Kind() {
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if bits <= 0 || bits > reflection.Bits() {
+			bits = reflection.Bits()
+		}
+		return uint64(1)<<(bits-1) - 1
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if bits <= 0 || bits > reflection.Bits() {
+			bits = reflection.Bits()
+		}
+		if bits == 64 {
+			return math.MaxUint64
+		}
+		return uint64(1)<<bits - 1
+
+	default:
+		return 0
+	}
+}
+
+func integerProperty(property packedProperty) (reflect.Type, string, uint64, bool) {
+
+	var reflection reflect.Type
+	bits := 0
+	name := ""
+
+	switch property.kind {
+
+	case kindConverter:
+		reflection = property.recieverType
+		bits = property.packed.(interface{ Size() int }).Size() * 8
+
+	case kindVariable:
+		reflection = property.recieverType
+
+	case kindConverterCast:
+		cast := property.packed.(converterCast)
+
+		reflection = cast.target
+		bits = cast.size * 8
+		name = cast.targetName()
+
+	case kindBitField:
+		field := property.packed.(packedBitField)
+
+		switch {
+		case field.bitFieldKind == bitFieldKindEnum:
+			reflection = field.enum.underlying
+			name = field.enum.name
+		case field.bitFieldKind == bitFieldKindInteger && field.flags == nil:
+			reflection = field.reflection
+		default:
+			return nil, "", 0, false
+		}
+
+		bits = field.bitSize
+
+	default:
+		return nil, "", 0, false
+	}
+
+	if name == "" {
+		name = reflection.String()
+	}
+
+	maximum := integerMaximum(reflection, bits)
+
+	return reflection, name, maximum, maximum > 0
+}
+
+func findProperty(properties []packedProperty, path string) (packedProperty, bool) {
+
+	name, rest, nested := strings.Cut(path, ".")
+
+	for _, property := range properties {
+
+		if property.kind == kindBitFieldGroup {
+
+			if nested {
+				continue
+			}
+
+			for _, field := range property.packed.(packedBitFieldGroup).fields {
+				if field.packedProperty.name == name {
+					return field.packedProperty, true
+				}
+			}
+
+			continue
+		}
+
+		if property.name != name {
+			continue
+		}
+
+		if !nested {
+			return property, true
+		}
+
+		if property.kind != kindStruct {
+			return packedProperty{}, false
+		}
+
+		return findProperty(property.packed.(packedStruct).properties, rest)
+	}
+
+	return packedProperty{}, false
+}
+
+func (s *packedSlice) resolveLength(properties []packedProperty) {
+
+	property, ok := findProperty(properties, s.lengthField)
+
+	if !ok {
+		panic(fmt.Sprintf("length field %s does not exist before the slice", s.lengthField))
+	}
+
+	reflection, name, maximum, ok := integerProperty(property)
+
+	if !ok {
+		panic(fmt.Sprintf("length field %s is not an integer", s.lengthField))
+	}
+
+	s.lengthType = reflection
+	s.lengthTypeName = name
+	s.lengthMaximum = maximum
+}
+
+var (
+	fieldPathReplacer = strings.NewReplacer("(*", "", ")", "")
+	fieldPathIndex    = regexp.MustCompile(`\[i\d+\]`)
+)
+
+func fieldPath(structure *packedStruct, reciever string) string {
+	reciever = fieldPathIndex.ReplaceAllString(fieldPathReplacer.Replace(reciever), "")
+	return structure.name + strings.TrimSuffix(strings.TrimPrefix(reciever, "reciever"), ".")
+}
+
+func (s packedSlice) writePrepare(buffer *bytes.Buffer, structure *packedStruct, reciever string, lengthReciever string) {
+
+	if s.lengthMaximum != math.MaxUint64 {
+		fmt.Fprintf(buffer, "if uint64(len(%s)) > %d {\n", reciever, s.lengthMaximum)
+		fmt.Fprintf(buffer, "return 0, &packed.FieldError{Path: %q, Err: packed.ErrInvalidLength}\n", fieldPath(structure, reciever))
+		fmt.Fprintf(buffer, "}\n")
+	}
+
+	fmt.Fprintf(buffer, "%s = %s(len(%s))\n", lengthReciever, s.lengthTypeName, reciever)
+}
+
+func (s packedSlice) writeSize(buffer *bytes.Buffer, reciever string) {
+
+	if s.ElementSize == 1 {
+		fmt.Fprintf(buffer, "size += len(%s)\n", reciever)
+		return
+	}
+
+	fmt.Fprintf(buffer, "size += len(%s) * %d\n", reciever, s.ElementSize)
+}
+
+func (s packedSlice) write(buffer *bytes.Buffer, structure *packedStruct, reciever string, lengthReciever string, functionName string, littleEndian bool, remaining int) {
+
+	if functionName == "FromBytes" {
+
+		length := fmt.Sprintf("int(%s)", lengthReciever)
+
+		if s.lengthType.Kind() < reflect.Uint || s.lengthType.Bits() == 64 {
+			fmt.Fprintf(buffer, "if %s < 0 {\n", length)
+			fmt.Fprintf(buffer, "return 0, &packed.FieldError{Path: %q, Err: packed.ErrInvalidLength}\n", fieldPath(structure, reciever))
+			fmt.Fprintf(buffer, "}\n")
+		}
+
+		if s.ElementSize == 1 {
+			fmt.Fprintf(buffer, "if available := len(bytes) - index - %d; available < 0 || uint64(%s) > uint64(available) {\n", remaining, length)
+		} else {
+			fmt.Fprintf(buffer, "if available := len(bytes) - index - %d; available < 0 || uint64(%s) > uint64(available)/%d {\n", remaining, length, s.ElementSize)
+		}
+
+		fmt.Fprintf(buffer, "return 0, &packed.FieldError{Path: %q, Err: packed.ErrShortBuffer}\n", fieldPath(structure, reciever))
+		fmt.Fprintf(buffer, "}\n")
+
+		switch s.sliceKind {
+
+		case sliceKindBytes:
+			fmt.Fprintf(buffer, "%s = make([]byte, %s)\n", reciever, length)
+			fmt.Fprintf(buffer, "copy(%s, bytes[index:])\n", reciever)
+			fmt.Fprintf(buffer, "index += len(%s)\n", reciever)
+			return
+
+		case sliceKindString:
+			fmt.Fprintf(buffer, "%s = string(bytes[index : index+%s])\n", reciever, length)
+			fmt.Fprintf(buffer, "index += len(%s)\n", reciever)
+			return
+		}
+
+		fmt.Fprintf(buffer, "%s = make(%s, %s)\n", reciever, s.recieverType, length)
+
+	} else if s.sliceKind != sliceKindElements {
+		fmt.Fprintf(buffer, "copy(bytes[index:], %s)\n", reciever)
+		fmt.Fprintf(buffer, "index += len(%s)\n", reciever)
+		return
+	}
+
+	writeElements(buffer, structure, s.Element, s.ElementKind, s.ElementSize, fmt.Sprintf("len(%s)", reciever), reciever, functionName, littleEndian, "index", 0)
+}
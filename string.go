@@ -0,0 +1,442 @@
+package packed
+
+import (
+	"bytes"
+	"fmt"
+	"unicode/utf16"
+	"unicode/utf8"
+	"unsafe"
+)
+
+type StringEncoding int
+
+const (
+	StringEncodingUTF8 StringEncoding = iota
+	StringEncodingLatin1
+	StringEncodingUTF16LE
+	StringEncodingUTF16BE
+)
+
+func String(length int) StringConverter {
+	return StringConverter{Length: length}
+}
+
+type StringConverter struct {
+	Length int `packed_hash_field:"length"`
+}
+
+func (s StringConverter) text() TextConverter { return TextConverter{Length: s.Length} }
+
+func (s StringConverter) Padded(pad byte) TextConverter { return s.text().Padded(pad) }
+
+func (s StringConverter) Terminated() TextConverter { return s.text().Terminated() }
+
+func (s StringConverter) NoTruncate() TextConverter { return s.text().NoTruncate() }
+
+func (s StringConverter) Encoded(encoding StringEncoding) TextConverter {
+	return s.text().Encoded(encoding)
+}
+
+func (s StringConverter) ValidUTF8() TextConverter { return s.text().ValidUTF8() }
+
+func (s StringConverter) Unsafe() TextConverter { return s.text().Unsafe() }
+
+func (s *StringConverter) InitializeConverterFields() map[string]string {
+	return map[string]string{
+		"Length": fmt.Sprintf("%v", s.Length),
+	}
+}
+
+func (s *StringConverter) Size() int { return s.Length }
+
+func (s *StringConverter) ToBytesLittleEndian(value *string, bytes []byte, index int) {
+	text := s.text()
+	text.ToBytesLittleEndian(value, bytes, index)
+}
+
+func (s *StringConverter) FromBytesLittleEndian(receiver *string, bytes []byte, index int) {
+	text := s.text()
+	text.FromBytesLittleEndian(receiver, bytes, index)
+}
+
+func (s *StringConverter) ToBytesBigEndian(value *string, bytes []byte, index int) {
+	s.ToBytesLittleEndian(value, bytes, index)
+}
+
+func (s *StringConverter) FromBytesBigEndian(receiver *string, bytes []byte, index int) {
+	s.FromBytesLittleEndian(receiver, bytes, index)
+}
+
+func Text(length int) TextConverter {
+	return TextConverter{Length: length}
+}
+
+type TextConverter struct {
+	Length           int            `packed_hash_field:"length"`
+	Pad              byte           `packed_hash_field:"pad"`
+	NullTerminated   bool           `packed_hash_field:"terminated"`
+	RejectTruncation bool           `packed_hash_field:"reject_truncation"`
+	Encoding         StringEncoding `packed_hash_field:"encoding"`
+	ValidateUTF8     bool           `packed_hash_field:"validate_utf8"`
+	ZeroCopy         bool           `packed_hash_field:"zero_copy"`
+}
+
+func (s TextConverter) Padded(pad byte) TextConverter {
+	s.Pad = pad
+	return s
+}
+
+func (s TextConverter) Terminated() TextConverter {
+
+	if s.Length < 1 {
+		panic("a null terminated string needs room for the terminator")
+	}
+
+	s.NullTerminated = true
+	return s
+}
+
+func (s TextConverter) NoTruncate() TextConverter {
+	s.RejectTruncation = true
+	return s
+}
+
+func (s TextConverter) Encoded(encoding StringEncoding) TextConverter {
+
+	if encoding < StringEncodingUTF8 || encoding > StringEncodingUTF16BE {
+		panic(fmt.Sprintf("unknown string encoding %d", encoding))
+	}
+
+	if s.ZeroCopy && encoding != StringEncodingUTF8 {
+		panic("zero copy strings must be utf-8 encoded")
+	}
+
+	s.Encoding = encoding
+	return s
+}
+
+func (s TextConverter) ValidUTF8() TextConverter {
+	s.ValidateUTF8 = true
+	return s
+}
+
+func (s TextConverter) Unsafe() TextConverter {
+
+	if s.Encoding != StringEncodingUTF8 {
+		panic("zero copy strings must be utf-8 encoded")
+	}
+
+	s.ZeroCopy = true
+	return s
+}
+
+func (s *TextConverter) InitializeConverterFields() map[string]string {
+	return map[string]string{
+		"Length":           fmt.Sprintf("%v", s.Length),
+		"Pad":              fmt.Sprintf("%d", s.Pad),
+		"NullTerminated":   fmt.Sprintf("%v", s.NullTerminated),
+		"RejectTruncation": fmt.Sprintf("%v", s.RejectTruncation),
+		"Encoding":         fmt.Sprintf("%d", s.Encoding),
+		"ValidateUTF8":     fmt.Sprintf("%v", s.ValidateUTF8),
+		"ZeroCopy":         fmt.Sprintf("%v", s.ZeroCopy),
+	}
+}
+
+func (s *TextConverter) unitSize() int {
+	if s.Encoding == StringEncodingUTF16LE || s.Encoding == StringEncodingUTF16BE {
+		return 2
+	}
+	return 1
+}
+
+func (s *TextConverter) Size() int { return s.Length * s.unitSize() }
+
+func (s *TextConverter) putUnit(bytes []byte, index int, unit uint16) {
+	switch s.Encoding {
+	case StringEncodingUTF16LE:
+		bytes[2*index] = byte(unit)
+		bytes[2*index+1] = byte(unit >> 8)
+	case StringEncodingUTF16BE:
+		bytes[2*index] = byte(unit >> 8)
+		bytes[2*index+1] = byte(unit)
+	default:
+		bytes[index] = byte(unit)
+	}
+}
+
+func (s *TextConverter) unit(bytes []byte, index int) uint16 {
+	switch s.Encoding {
+	case StringEncodingUTF16LE:
+		return uint16(bytes[2*index]) | uint16(bytes[2*index+1])<<8
+	case StringEncodingUTF16BE:
+		return uint16(bytes[2*index])<<8 | uint16(bytes[2*index+1])
+	default:
+		return uint16(bytes[index])
+	}
+}
+
+func (s *TextConverter) encode(value string, bytes []byte) error {
+
+	if s.ValidateUTF8 && !utf8.ValidString(value) {
+		return fmt.Errorf("%w: %q is not valid utf-8", ErrInvalidValue, value)
+	}
+
+	capacity := s.Length
+	written := 0
+
+	if s.NullTerminated {
+		capacity--
+	}
+
+	switch s.Encoding {
+
+	case StringEncodingUTF8:
+		written = len(value)
+
+		if written > capacity {
+			if s.RejectTruncation {
+				return fmt.Errorf("%w: %q does not fit in %d code units", ErrOverflow, value, capacity)
+			}
+			written = capacity
+			start := written
+
+			for start > 0 && written-start < utf8.UTFMax-1 && !utf8.RuneStart(value[start]) {
+				start--
+			}
+
+			if _, size := utf8.DecodeRuneInString(value[start:]); size > 1 && start+size > written {
+				written = start
+			}
+		}
+
+		copy(bytes, value[:written])
+
+	case StringEncodingLatin1:
+		for _, r := range value {
+
+			if written == capacity {
+				if s.RejectTruncation {
+					return fmt.Errorf("%w: %q does not fit in %d code units", ErrOverflow, value, capacity)
+				}
+				break
+			}
+
+			if r > 0xFF {
+				return fmt.Errorf("%w: %q cannot be encoded as latin-1", ErrInvalidValue, r)
+			}
+
+			bytes[written] = byte(r)
+			written++
+		}
+
+	default:
+		for _, r := range value {
+
+			units := utf16.RuneLen(r)
+
+			if written+units > capacity {
+				if s.RejectTruncation {
+					return fmt.Errorf("%w: %q does not fit in %d code units", ErrOverflow, value, capacity)
+				}
+				break
+			}
+
+			if units == 2 {
+				high, low := utf16.EncodeRune(r)
+				s.putUnit(bytes, written, uint16(high))
+				s.putUnit(bytes, written+1, uint16(low))
+			} else {
+				s.putUnit(bytes, written, uint16(r))
+			}
+
+			written += units
+		}
+	}
+
+	if s.NullTerminated {
+		s.putUnit(bytes, written, 0)
+		written++
+	}
+
+	for ; written < s.Length; written++ {
+		s.putUnit(bytes, written, uint16(s.Pad))
+	}
+
+	return nil
+}
+
+func (s *TextConverter) verify(bytes []byte) (int, error) {
+
+	length := s.Length
+
+	if s.NullTerminated {
+
+		length = -1
+
+		for i := 0; i < s.Length; i++ {
+			if s.unit(bytes, i) == 0 {
+				length = i
+				break
+			}
+		}
+
+		if length < 0 {
+			return 0, fmt.Errorf("%w: string is not null terminated", ErrInvalidValue)
+		}
+
+	} else {
+		for length > 0 && s.unit(bytes, length-1) == uint16(s.Pad) {
+			length--
+		}
+	}
+
+	if s.Encoding == StringEncodingUTF8 && s.ValidateUTF8 && !utf8.Valid(bytes[:length]) {
+		return 0, fmt.Errorf("%w: %q is not valid utf-8", ErrInvalidValue, bytes[:length])
+	}
+
+	return length, nil
+}
+
+func (s *TextConverter) Verify(bytes []byte, index int) error {
+	_, err := s.verify(bytes[index : index+s.Size()])
+	return err
+}
+
+func (s *TextConverter) decode(bytes []byte) (string, error) {
+
+	length, err := s.verify(bytes)
+
+	if err != nil {
+		return "", err
+	}
+
+	switch s.Encoding {
+
+	case StringEncodingLatin1:
+		value := make([]byte, 0, length)
+
+		for _, b := range bytes[:length] {
+			value = utf8.AppendRune(value, rune(b))
+		}
+
+		return string(value), nil
+
+	case StringEncodingUTF16LE, StringEncodingUTF16BE:
+		units := make([]uint16, length)
+
+		for i := range units {
+			units[i] = s.unit(bytes, i)
+		}
+
+		return string(utf16.Decode(units)), nil
+	}
+
+	if s.ZeroCopy {
+
+		if length == 0 {
+			return "", nil
+		}
+
+		return unsafe.String(&bytes[0], length), nil
+	}
+
+	return string(bytes[:length]), nil
+}
+
+func (s *TextConverter) ToBytesLittleEndian(value *string, bytes []byte, index int) error {
+	return s.encode(*value, bytes[index:index+s.Size()])
+}
+
+func (s *TextConverter) FromBytesLittleEndian(receiver *string, bytes []byte, index int) error {
+
+	value, err := s.decode(bytes[index : index+s.Size()])
+
+	if err != nil {
+		return err
+	}
+
+	*receiver = value
+	return nil
+}
+
+func (s *TextConverter) ToBytesBigEndian(value *string, bytes []byte, index int) error {
+	return s.ToBytesLittleEndian(value, bytes, index)
+}
+
+func (s *TextConverter) FromBytesBigEndian(receiver *string, bytes []byte, index int) error {
+	return s.FromBytesLittleEndian(receiver, bytes, index)
+}
+
+type packedFixedString struct {
+	name      string
+	converter converterHash
+	size      int
+}
+
+func FixedString(name string, converter any) packedArray {
+
+	var text TextConverter
+
+	switch converter := converter.(type) {
+	case StringConverter:
+		text = converter.text()
+	case TextConverter:
+		text = converter
+	default:
+		panic(fmt.Sprintf("fixed string %s needs a string converter, got %T", name, converter))
+	}
+
+	if _, ok := fixedStrings[name]; ok {
+		panic(fmt.Sprintf("fixed string %s already exists", name))
+	}
+
+	if _, ok := enums[name]; ok {
+		panic(fmt.Sprintf("fixed string %s conflicts with an enum of the same name", name))
+	}
+
+	if _, ok := flagSets[name]; ok {
+		panic(fmt.Sprintf("fixed string %s conflicts with flags of the same name", name))
+	}
+
+	hash := createConverterHash(toPointer(text))
+
+	if _, exists := converters[hash.hash]; !exists {
+		converters[hash.hash] = hash
+	}
+
+	fixedStrings[name] = &packedFixedString{name: name, converter: hash, size: text.Size()}
+
+	array := Array(text.Size(), Uint8)
+	array.recieverType = name
+	array.fixedString = fixedStrings[name]
+
+	return array
+}
+
+func (f *packedFixedString) definition() []byte {
+
+	buffer := &bytes.Buffer{}
+	converter := getConverterName(f.converter.hash)
+
+	fmt.Fprintf(buffer, "// %s is a %d byte fixed string, FromBytes rejects bytes that do not decode and String returns an empty string for them.\n", f.name, f.size)
+	fmt.Fprintf(buffer, "type %s [%d]byte\n\n", f.name, f.size)
+
+	fmt.Fprintf(buffer, "func (s %s) Value() (string, error) {\n", f.name)
+	fmt.Fprintf(buffer, "var value string\n")
+	fmt.Fprintf(buffer, "err := %s.FromBytesLittleEndian(&value, s[:], 0)\n", converter)
+	fmt.Fprintf(buffer, "return value, err\n")
+	fmt.Fprintf(buffer, "}\n\n")
+
+	fmt.Fprintf(buffer, "func (s %s) String() string {\n", f.name)
+	fmt.Fprintf(buffer, "value, _ := s.Value()\n")
+	fmt.Fprintf(buffer, "return value\n")
+	fmt.Fprintf(buffer, "}\n\n")
+
+	fmt.Fprintf(buffer, "func Parse%s(value string) (%s, error) {\n", f.name, f.name)
+	fmt.Fprintf(buffer, "var s %s\n", f.name)
+	fmt.Fprintf(buffer, "err := %s.ToBytesLittleEndian(&value, s[:], 0)\n", converter)
+	fmt.Fprintf(buffer, "return s, err\n")
+	fmt.Fprintf(buffer, "}\n")
+
+	return buffer.Bytes()
+}
@@ -0,0 +1,209 @@
+package packed
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestStringConverterOptions(t *testing.T) {
+
+	tests := []struct {
+		name      string
+		converter TextConverter
+		value     string
+		expected  []byte
+		decoded   string
+	}{
+		{"default", Text(4), "AB", []byte{'A', 'B', 0, 0}, "AB"},
+		{"truncated", Text(2), "ABC", []byte{'A', 'B'}, "AB"},
+		{"truncated rune", String(4).ValidUTF8(), "abcé", []byte{'a', 'b', 'c', 0}, "abc"},
+		{"truncated binary", Text(2), "\x80\x80\x80", []byte{0x80, 0x80}, "\x80\x80"},
+		{"truncated terminated rune", String(4).Terminated(), "abé", []byte{'a', 'b', 0, 0}, "ab"},
+		{"space padded", String(4).Padded(' '), "AB", []byte{'A', 'B', ' ', ' '}, "AB"},
+		{"terminated", String(4).Terminated(), "AB", []byte{'A', 'B', 0, 0}, "AB"},
+		{"terminated padded", String(4).Terminated().Padded(' '), "AB", []byte{'A', 'B', 0, ' '}, "AB"},
+		{"terminated truncated", String(3).Terminated(), "ABC", []byte{'A', 'B', 0}, "AB"},
+		{"latin-1", String(3).Encoded(StringEncodingLatin1), "Zoë", []byte{'Z', 'o', 0xEB}, "Zoë"},
+		{"utf-16le", String(3).Encoded(StringEncodingUTF16LE), "Hé", []byte{'H', 0, 0xE9, 0, 0, 0}, "Hé"},
+		{"utf-16be", String(3).Encoded(StringEncodingUTF16BE).Padded(' '), "Hé", []byte{0, 'H', 0, 0xE9, 0, ' '}, "Hé"},
+		{"utf-16 surrogate", String(3).Encoded(StringEncodingUTF16LE), "a😀", []byte{'a', 0, 0x3D, 0xD8, 0x00, 0xDE}, "a😀"},
+		{"utf-16 surrogate truncated", String(2).Encoded(StringEncodingUTF16LE), "a😀", []byte{'a', 0, 0, 0}, "a"},
+		{"utf-8 valid", String(3).ValidUTF8(), "é", []byte{0xC3, 0xA9, 0}, "é"},
+	}
+
+	for _, test := range tests {
+
+		bytes := make([]byte, test.converter.Size()+1)
+		bytes[0] = 0xAA
+
+		if err := test.converter.ToBytesLittleEndian(&test.value, bytes, 1); err != nil {
+			t.Errorf("%s: unexpected error %v", test.name, err)
+			continue
+		}
+
+		if !reflect.DeepEqual(bytes[1:], test.expected) || bytes[0] != 0xAA {
+			t.Errorf("%s: expected %x, got %x", test.name, test.expected, bytes[1:])
+		}
+
+		var result string
+
+		if err := test.converter.FromBytesBigEndian(&result, bytes, 1); err != nil || result != test.decoded {
+			t.Errorf("%s: expected %q, got %q (%v)", test.name, test.decoded, result, err)
+		}
+	}
+}
+
+func TestStringConverterErrors(t *testing.T) {
+
+	encode := []struct {
+		name      string
+		converter TextConverter
+		value     string
+		err       error
+	}{
+		{"no truncate", String(2).NoTruncate(), "ABC", ErrOverflow},
+		{"no truncate terminated", String(3).Terminated().NoTruncate(), "ABC", ErrOverflow},
+		{"no truncate utf-16", String(2).Encoded(StringEncodingUTF16BE).NoTruncate(), "a😀", ErrOverflow},
+		{"latin-1 range", String(4).Encoded(StringEncodingLatin1), "€", ErrInvalidValue},
+		{"invalid utf-8", String(4).ValidUTF8(), "\xff", ErrInvalidValue},
+	}
+
+	for _, test := range encode {
+
+		bytes := make([]byte, test.converter.Size())
+
+		if err := test.converter.ToBytesLittleEndian(&test.value, bytes, 0); !errors.Is(err, test.err) {
+			t.Errorf("%s: expected %v, got %v", test.name, test.err, err)
+		}
+	}
+
+	decode := []struct {
+		name      string
+		converter TextConverter
+		bytes     []byte
+	}{
+		{"missing terminator", String(2).Terminated(), []byte{'A', 'B'}},
+		{"invalid utf-8", String(2).ValidUTF8(), []byte{0xC3, 'A'}},
+	}
+
+	for _, test := range decode {
+
+		result := "unchanged"
+
+		if err := test.converter.FromBytesLittleEndian(&result, test.bytes, 0); !errors.Is(err, ErrInvalidValue) || result != "unchanged" {
+			t.Errorf("%s: expected invalid value, got %q (%v)", test.name, result, err)
+		}
+
+		if err := test.converter.Verify(append([]byte{0}, test.bytes...), 1); !errors.Is(err, ErrInvalidValue) {
+			t.Errorf("%s: expected verify to report invalid value, got %v", test.name, err)
+		}
+	}
+
+	converter := String(3).Terminated().ValidUTF8()
+
+	if err := converter.Verify([]byte("ab\x00"), 0); err != nil {
+		t.Errorf("verify: unexpected error %v", err)
+	}
+}
+
+func TestStringConverterHash(t *testing.T) {
+
+	if createConverterHash(String(4)).hash != createConverterHash(String(4)).hash {
+		t.Errorf("equal string converters should share a hash")
+	}
+
+	if createConverterHash(String(4)).hash == createConverterHash(String(4).Padded(' ')).hash {
+		t.Errorf("string converters with different padding should not share a hash")
+	}
+}
+
+func TestStringConverterInfallible(t *testing.T) {
+
+	var converter interface {
+		ToBytesLittleEndian(*string, []byte, int)
+		FromBytesLittleEndian(*string, []byte, int)
+	} = &StringConverter{Length: 4}
+
+	value := "ABCDEF"
+	bytes := []byte{0xFF, 0xFF, 0xFF, 0xFF}
+
+	converter.ToBytesLittleEndian(&value, bytes, 0)
+
+	var result string
+	converter.FromBytesLittleEndian(&result, bytes, 0)
+
+	if result != "ABCD" {
+		t.Errorf("infallible: expected %q, got %q", "ABCD", result)
+	}
+
+	if text := String(4).Padded(' '); text != (TextConverter{Length: 4, Pad: ' '}) {
+		t.Errorf("options: expected a text converter, got %+v", text)
+	}
+}
+
+func TestStringConverterUnsafe(t *testing.T) {
+
+	converter := String(8).Padded(' ').Unsafe()
+	bytes := []byte{'n', 'a', 'm', 'e', ' ', ' ', ' ', ' '}
+
+	var result string
+
+	allocations := testing.AllocsPerRun(100, func() {
+		converter.FromBytesLittleEndian(&result, bytes, 0)
+	})
+
+	if allocations != 0 {
+		t.Errorf("unsafe: expected no allocations, got %v", allocations)
+	}
+
+	if result != "name" {
+		t.Errorf("unsafe: expected %q, got %q", "name", result)
+	}
+
+	bytes[0] = 'g'
+
+	if result != "game" {
+		t.Errorf("unsafe: expected the result to alias the buffer, got %q", result)
+	}
+
+	empty := make([]byte, 8)
+
+	if err := converter.FromBytesLittleEndian(&result, empty, 0); err != nil || result != "\x00\x00\x00\x00\x00\x00\x00\x00" {
+		t.Errorf("unsafe: unexpected result %q (%v)", result, err)
+	}
+
+	converter = String(4).Unsafe()
+
+	if err := converter.FromBytesLittleEndian(&result, empty, 0); err != nil || result != "" {
+		t.Errorf("unsafe empty: unexpected result %q (%v)", result, err)
+	}
+}
+
+func BenchmarkStringDecode(b *testing.B) {
+
+	converter := String(16)
+	bytes := []byte("packed-record\x00\x00\x00")
+
+	var result string
+
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		converter.FromBytesLittleEndian(&result, bytes, 0)
+	}
+}
+
+func BenchmarkStringDecodeUnsafe(b *testing.B) {
+
+	converter := String(16).Unsafe()
+	bytes := []byte("packed-record\x00\x00\x00")
+
+	var result string
+
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		converter.FromBytesLittleEndian(&result, bytes, 0)
+	}
+}
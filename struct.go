@@ -10,6 +10,11 @@ type packedStruct struct {
 	properties             []packedProperty
 	size                   int
 	littleEndian           bool
+	variable               bool
+	prepared               bool
+	checksummed            bool
+	verified               bool
+	alignment              int
 	converterCastRecievers map[reflect.Type]int
 }
 
@@ -103,6 +108,26 @@ func (p *packedStruct) getConverterCastRecievers(converterCastRecievers map[refl
 
 			child.packed = packed
 
+		case kindSlice:
+			packed := child.packed.(packedSlice)
+
+			switch packed.ElementKind {
+
+			case kindStruct:
+				structure := packed.Element.(packedStruct)
+				structure.getConverterCastRecievers(converterCastRecievers)
+				packed.Element = structure
+
+			case kindConverterCast:
+				cast := packed.Element.(converterCast)
+
+				if _, ok := converterCastRecievers[cast.reciever]; !ok {
+					converterCastRecievers[cast.reciever] = len(converterCastRecievers)
+				}
+			}
+
+			child.packed = packed
+
 		default:
 			continue
 		}
@@ -122,23 +147,60 @@ func createBitFieldGroup(fields []packedBitField, littleEndian bool) packedPrope
 		littleEndian: littleEndian,
 	}
 
+	for _, field := range fields {
+		packed.prepared = packed.prepared || field.packedProperty.prepared
+	}
+
 	return packed
 }
 
 func Struct(name string, littleEndian bool, properties ...packedProperty) packedStruct {
+	return AlignedStruct(name, littleEndian, Packed, properties...)
+}
+
+func AlignedStruct(name string, littleEndian bool, alignment structAlignment, properties ...packedProperty) packedStruct {
 
 	if _, ok := structs[name]; ok {
 		panic(fmt.Sprintf("struct %s already exists", name))
 	}
 
+	if unions[name] {
+		panic(fmt.Sprintf("struct %s conflicts with a union of the same name", name))
+	}
+
 	processedProperties := []packedProperty{}
 	currentBitFields := []packedBitField{}
 	propertyNames := map[string]bool{}
+	controlledFields := map[string]bool{}
 	size := 0
+	variable := false
+	prepared := false
+	structureAlignment := 1
+
+	addPadding := func(memberAlignment int) {
+
+		if memberAlignment > structureAlignment {
+			structureAlignment = memberAlignment
+		}
+
+		if variable && memberAlignment > 1 {
+			panic(fmt.Sprintf("struct %s cannot align properties after a variable size property", name))
+		}
+
+		if size%memberAlignment == 0 {
+			return
+		}
+
+		padding := Padding(memberAlignment - size%memberAlignment)
+		processedProperties = append(processedProperties, padding)
+		size += padding.size
+	}
 
 	addBitFieldGroup := func(fields []packedBitField, littleEndian bool) {
 		property := createBitFieldGroup(fields, littleEndian)
+		addPadding(alignment.member(property))
 		processedProperties = append(processedProperties, property)
+		prepared = prepared || property.prepared
 		size += property.size
 	}
 
@@ -146,7 +208,7 @@ func Struct(name string, littleEndian bool, properties ...packedProperty) packed
 
 		if _, ok := propertyNames[property.name]; ok {
 			panic(fmt.Sprintf("property %s already exists", property.name))
-		} else {
+		} else if property.name != "" {
 			propertyNames[property.name] = true
 		}
 
@@ -156,11 +218,60 @@ func Struct(name string, littleEndian bool, properties ...packedProperty) packed
 				currentBitFields = nil
 			}
 
+			if property.kind == kindSlice {
+				slice := property.packed.(packedSlice)
+				slice.resolveLength(processedProperties)
+
+				if controlledFields[slice.lengthField] {
+					panic(fmt.Sprintf("length field %s is already controlled by another property", slice.lengthField))
+				}
+
+				controlledFields[slice.lengthField] = true
+				property.packed = slice
+			}
+
+			if property.kind == kindUnion {
+				union := property.packed.(packedUnion)
+				union.resolveDiscriminator(processedProperties, name+property.name)
+
+				if controlledFields[union.discriminatorField] {
+					panic(fmt.Sprintf("discriminator field %s is already controlled by another property", union.discriminatorField))
+				}
+
+				controlledFields[union.discriminatorField] = true
+				property.packed = union
+			}
+
+			if property.computed.kind != computedNone {
+				controlledFields[property.name] = true
+			}
+
+			if property.when != "" {
+				resolvePresenceFlag(processedProperties, property.when)
+
+				if controlledFields[property.when] {
+					panic(fmt.Sprintf("presence flag %s is already controlled by another property", property.when))
+				}
+
+				controlledFields[property.when] = true
+			}
+
+			addPadding(alignment.member(property))
 			processedProperties = append(processedProperties, property)
-			size += property.size
+			variable = variable || property.variable
+			prepared = prepared || property.prepared
+
+			if property.when == "" {
+				size += property.size
+			}
+
 			continue
 		}
 
+		if property.computed.kind != computedNone {
+			controlledFields[property.name] = true
+		}
+
 		bitField := property.packed.(packedBitField)
 		bitField.packedProperty = property
 
@@ -183,10 +294,56 @@ func Struct(name string, littleEndian bool, properties ...packedProperty) packed
 		addBitFieldGroup(currentBitFields, littleEndian)
 	}
 
+	if !variable {
+		addPadding(structureAlignment)
+	}
+
+	checksummed := false
+	verified := false
+
+	for i, property := range processedProperties {
+
+		if property.computed.kind != computedNone {
+			property.computed.resolve(processedProperties, property.name)
+			processedProperties[i] = property
+			verified = verified || property.computed.verify
+		}
+
+		switch property.kind {
+
+		case kindChecksum:
+			checksum := property.packed.(packedChecksum)
+			checksum.resolveRange(processedProperties, i)
+			property.packed = checksum
+			processedProperties[i] = property
+			checksummed = true
+
+		case kindStruct:
+			checksummed = checksummed || property.packed.(packedStruct).checksummed
+			verified = verified || property.packed.(packedStruct).verified
+
+		case kindBitFieldGroup:
+			group := property.packed.(packedBitFieldGroup)
+
+			for j, field := range group.fields {
+				if field.packedProperty.computed.kind != computedNone {
+					field.packedProperty.computed.resolve(processedProperties, field.packedProperty.name)
+					group.fields[j] = field
+					verified = verified || field.packedProperty.computed.verify
+				}
+			}
+		}
+	}
+
 	packed := packedStruct{
 		name:                   name,
 		size:                   size,
 		littleEndian:           littleEndian,
+		variable:               variable,
+		prepared:               prepared,
+		checksummed:            checksummed,
+		verified:               verified,
+		alignment:              structureAlignment,
 		properties:             processedProperties,
 		converterCastRecievers: map[reflect.Type]int{},
 	}
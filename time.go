@@ -0,0 +1,553 @@
+package packed
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+var (
+	unixEpoch = time.Unix(0, 0).UTC()
+	gpsEpoch  = time.Date(1980, time.January, 6, 0, 0, 0, 0, time.UTC)
+
+	UnixSeconds32 = Timestamp(4, true, unixEpoch, time.Second)
+	UnixSeconds64 = Timestamp(8, true, unixEpoch, time.Second)
+	UnixMillis    = Timestamp(8, true, unixEpoch, time.Millisecond)
+	UnixMicros    = Timestamp(8, true, unixEpoch, time.Microsecond)
+	UnixNanos     = Timestamp(8, true, unixEpoch, time.Nanosecond)
+	FileTime      = Timestamp(8, false, time.Date(1601, time.January, 1, 0, 0, 0, 0, time.UTC), 100*time.Nanosecond)
+	NTPTime       = NTPTimestamp(time.Date(1900, time.January, 1, 0, 0, 0, 0, time.UTC))
+	GPSTime       = GPSWeekTime(2, 4, gpsEpoch, time.Millisecond)
+	DOSDateTime   = DOSTimestamp(1980)
+)
+
+const (
+	secondsFromYearOneToUnixEpoch = (1969*365 + 1969/4 - 1969/100 + 1969/400) * 24 * 60 * 60
+	maxUnixSeconds                = math.MaxInt64 - secondsFromYearOneToUnixEpoch
+)
+
+func epochSeconds(epoch time.Time) int64 {
+
+	if epoch.Nanosecond() != 0 {
+		panic(fmt.Sprintf("epoch %s must be a whole second", epoch))
+	}
+
+	return epoch.Unix()
+}
+
+func validateResolution(resolution time.Duration) {
+	if resolution <= 0 || (resolution < time.Second && time.Second%resolution != 0) || (resolution > time.Second && resolution%time.Second != 0) {
+		panic(fmt.Sprintf("resolution %s must divide or be a multiple of a second", resolution))
+	}
+}
+
+func validateTickBytes(bytes int) {
+	if bytes < 1 || bytes > 8 {
+		panic(fmt.Sprintf("time bytes must be between 1 and 8, got %d", bytes))
+	}
+}
+
+func tickRange(bytes int, signed bool) (int64, uint64) {
+
+	if signed {
+		maximum := lowBits(8*bytes - 1)
+		return -int64(maximum) - 1, maximum
+	}
+
+	return 0, lowBits(8 * bytes)
+}
+
+func floorDivision(a, b int64) int64 {
+	quotient := a / b
+	if a%b != 0 && (a < 0) != (b < 0) {
+		quotient--
+	}
+	return quotient
+}
+
+func Timestamp(bytes int, signed bool, epoch time.Time, resolution time.Duration) TimestampConverter {
+
+	validateTickBytes(bytes)
+	validateResolution(resolution)
+
+	return TimestampConverter{Bytes: bytes, Signed: signed, Epoch: epochSeconds(epoch), Resolution: resolution}
+}
+
+type TimestampConverter struct {
+	Bytes      int           `packed_hash_field:"bytes"`
+	Signed     bool          `packed_hash_field:"signed"`
+	Epoch      int64         `packed_hash_field:"epoch"`
+	Resolution time.Duration `packed_hash_field:"resolution"`
+}
+
+func (c *TimestampConverter) InitializeConverterFields() map[string]string {
+	return map[string]string{
+		"Bytes":      fmt.Sprintf("%d", c.Bytes),
+		"Signed":     fmt.Sprintf("%v", c.Signed),
+		"Epoch":      fmt.Sprintf("%d", c.Epoch),
+		"Resolution": fmt.Sprintf("%d", int64(c.Resolution)),
+	}
+}
+
+func (c *TimestampConverter) Size() int { return c.Bytes }
+
+func (c *TimestampConverter) ticks(value *time.Time) (uint64, error) {
+
+	seconds := value.Unix() - c.Epoch
+	overflow := fmt.Errorf("%w: %s is out of range for %d byte timestamps", ErrOverflow, value, c.Bytes)
+
+	var ticks int64
+
+	if c.Resolution <= time.Second {
+		perSecond := int64(time.Second / c.Resolution)
+
+		if seconds > math.MaxInt64/perSecond || seconds < math.MinInt64/perSecond+1 {
+			return 0, overflow
+		}
+
+		ticks = seconds*perSecond + int64(value.Nanosecond())/int64(c.Resolution)
+	} else {
+		ticks = floorDivision(seconds, int64(c.Resolution/time.Second))
+	}
+
+	minimum, maximum := tickRange(c.Bytes, c.Signed)
+
+	if ticks < minimum || (ticks > 0 && uint64(ticks) > maximum) {
+		return 0, overflow
+	}
+
+	return uint64(ticks) & lowBits(8*c.Bytes), nil
+}
+
+func (c *TimestampConverter) time(ticks uint64) (time.Time, error) {
+
+	overflow := fmt.Errorf("%w: %d ticks are out of range for time values", ErrOverflow, ticks)
+
+	var seconds, nanoseconds int64
+
+	if c.Signed {
+		shift := 64 - 8*c.Bytes
+		signed := int64(ticks<<shift) >> shift
+
+		if c.Resolution > time.Second {
+			perTick := int64(c.Resolution / time.Second)
+
+			if signed > math.MaxInt64/perTick || signed < math.MinInt64/perTick {
+				return time.Time{}, overflow
+			}
+
+			seconds = signed * perTick
+		} else {
+			perSecond := int64(time.Second / c.Resolution)
+			seconds = floorDivision(signed, perSecond)
+			nanoseconds = (signed - seconds*perSecond) * int64(c.Resolution)
+		}
+
+	} else if c.Resolution > time.Second {
+		perTick := uint64(c.Resolution / time.Second)
+
+		if ticks > math.MaxInt64/perTick {
+			return time.Time{}, overflow
+		}
+
+		seconds = int64(ticks * perTick)
+
+	} else {
+		perSecond := uint64(time.Second / c.Resolution)
+
+		if ticks/perSecond > math.MaxInt64 {
+			return time.Time{}, overflow
+		}
+
+		seconds = int64(ticks / perSecond)
+		nanoseconds = int64(ticks%perSecond) * int64(c.Resolution)
+	}
+
+	if seconds > maxUnixSeconds-c.Epoch || (c.Epoch < 0 && seconds < math.MinInt64-c.Epoch) {
+		return time.Time{}, overflow
+	}
+
+	return time.Unix(c.Epoch+seconds, nanoseconds).UTC(), nil
+}
+
+func (c *TimestampConverter) ToBytesLittleEndian(value *time.Time, bytes []byte, index int) error {
+	ticks, err := c.ticks(value)
+	if err != nil {
+		return err
+	}
+	putInteger(ticks, c.Bytes, bytes, index, true)
+	return nil
+}
+
+func (c *TimestampConverter) FromBytesLittleEndian(reciever *time.Time, bytes []byte, index int) error {
+	value, err := c.time(getInteger(c.Bytes, bytes, index, true))
+	if err != nil {
+		return err
+	}
+	*reciever = value
+	return nil
+}
+
+func (c *TimestampConverter) ToBytesBigEndian(value *time.Time, bytes []byte, index int) error {
+	ticks, err := c.ticks(value)
+	if err != nil {
+		return err
+	}
+	putInteger(ticks, c.Bytes, bytes, index, false)
+	return nil
+}
+
+func (c *TimestampConverter) FromBytesBigEndian(reciever *time.Time, bytes []byte, index int) error {
+	value, err := c.time(getInteger(c.Bytes, bytes, index, false))
+	if err != nil {
+		return err
+	}
+	*reciever = value
+	return nil
+}
+
+func Duration(bytes int, signed bool, resolution time.Duration) DurationConverter {
+
+	validateTickBytes(bytes)
+
+	if resolution <= 0 {
+		panic(fmt.Sprintf("resolution must be positive, got %s", resolution))
+	}
+
+	return DurationConverter{Bytes: bytes, Signed: signed, Resolution: resolution}
+}
+
+type DurationConverter struct {
+	Bytes      int           `packed_hash_field:"bytes"`
+	Signed     bool          `packed_hash_field:"signed"`
+	Resolution time.Duration `packed_hash_field:"resolution"`
+}
+
+func (c *DurationConverter) InitializeConverterFields() map[string]string {
+	return map[string]string{
+		"Bytes":      fmt.Sprintf("%d", c.Bytes),
+		"Signed":     fmt.Sprintf("%v", c.Signed),
+		"Resolution": fmt.Sprintf("%d", int64(c.Resolution)),
+	}
+}
+
+func (c *DurationConverter) Size() int { return c.Bytes }
+
+func (c *DurationConverter) ticks(value *time.Duration) (uint64, error) {
+
+	ticks := int64(*value / c.Resolution)
+	minimum, maximum := tickRange(c.Bytes, c.Signed)
+
+	if ticks < minimum || (ticks > 0 && uint64(ticks) > maximum) {
+		return 0, fmt.Errorf("%w: %s is out of range for %d byte durations", ErrOverflow, *value, c.Bytes)
+	}
+
+	return uint64(ticks) & lowBits(8*c.Bytes), nil
+}
+
+func (c *DurationConverter) duration(ticks uint64) (time.Duration, error) {
+
+	overflow := fmt.Errorf("%w: %d ticks are out of range for durations", ErrOverflow, ticks)
+
+	if c.Signed {
+		shift := 64 - 8*c.Bytes
+		signed := int64(ticks<<shift) >> shift
+
+		if signed > math.MaxInt64/int64(c.Resolution) || signed < math.MinInt64/int64(c.Resolution) {
+			return 0, overflow
+		}
+
+		return time.Duration(signed) * c.Resolution, nil
+	}
+
+	if ticks > math.MaxInt64/uint64(c.Resolution) {
+		return 0, overflow
+	}
+
+	return time.Duration(ticks) * c.Resolution, nil
+}
+
+func (c *DurationConverter) ToBytesLittleEndian(value *time.Duration, bytes []byte, index int) error {
+	ticks, err := c.ticks(value)
+	if err != nil {
+		return err
+	}
+	putInteger(ticks, c.Bytes, bytes, index, true)
+	return nil
+}
+
+func (c *DurationConverter) FromBytesLittleEndian(reciever *time.Duration, bytes []byte, index int) error {
+	value, err := c.duration(getInteger(c.Bytes, bytes, index, true))
+	if err != nil {
+		return err
+	}
+	*reciever = value
+	return nil
+}
+
+func (c *DurationConverter) ToBytesBigEndian(value *time.Duration, bytes []byte, index int) error {
+	ticks, err := c.ticks(value)
+	if err != nil {
+		return err
+	}
+	putInteger(ticks, c.Bytes, bytes, index, false)
+	return nil
+}
+
+func (c *DurationConverter) FromBytesBigEndian(reciever *time.Duration, bytes []byte, index int) error {
+	value, err := c.duration(getInteger(c.Bytes, bytes, index, false))
+	if err != nil {
+		return err
+	}
+	*reciever = value
+	return nil
+}
+
+func NTPTimestamp(epoch time.Time) NTPConverter {
+	return NTPConverter{Epoch: epochSeconds(epoch)}
+}
+
+type NTPConverter struct {
+	Epoch int64 `packed_hash_field:"epoch"`
+}
+
+func (c *NTPConverter) InitializeConverterFields() map[string]string {
+	return map[string]string{"Epoch": fmt.Sprintf("%d", c.Epoch)}
+}
+
+func (c *NTPConverter) Size() int { return 8 }
+
+func (c *NTPConverter) integer(value *time.Time) (uint64, error) {
+
+	seconds := value.Unix() - c.Epoch
+
+	if seconds < 0 || seconds > math.MaxUint32 {
+		return 0, fmt.Errorf("%w: %s is outside of the ntp era", ErrOverflow, value)
+	}
+
+	fraction := uint64(value.Nanosecond()) << 32 / uint64(time.Second)
+
+	return uint64(seconds)<<32 | fraction, nil
+}
+
+func (c *NTPConverter) time(integer uint64) time.Time {
+	nanoseconds := (integer&math.MaxUint32*uint64(time.Second) + 1<<31) >> 32
+	return time.Unix(c.Epoch+int64(integer>>32), int64(nanoseconds)).UTC()
+}
+
+func (c *NTPConverter) ToBytesLittleEndian(value *time.Time, bytes []byte, index int) error {
+	integer, err := c.integer(value)
+	if err != nil {
+		return err
+	}
+	putInteger(integer, 8, bytes, index, true)
+	return nil
+}
+
+func (c *NTPConverter) FromBytesLittleEndian(reciever *time.Time, bytes []byte, index int) {
+	*reciever = c.time(getInteger(8, bytes, index, true))
+}
+
+func (c *NTPConverter) ToBytesBigEndian(value *time.Time, bytes []byte, index int) error {
+	integer, err := c.integer(value)
+	if err != nil {
+		return err
+	}
+	putInteger(integer, 8, bytes, index, false)
+	return nil
+}
+
+func (c *NTPConverter) FromBytesBigEndian(reciever *time.Time, bytes []byte, index int) {
+	*reciever = c.time(getInteger(8, bytes, index, false))
+}
+
+const secondsPerWeek = 7 * 24 * 60 * 60
+
+func GPSWeekTime(weekBytes, timeOfWeekBytes int, epoch time.Time, resolution time.Duration) GPSTimeConverter {
+
+	validateTickBytes(weekBytes)
+	validateTickBytes(timeOfWeekBytes)
+
+	if resolution <= 0 || (7*24*time.Hour)%resolution != 0 {
+		panic(fmt.Sprintf("resolution %s must divide a week", resolution))
+	}
+
+	return GPSTimeConverter{WeekBytes: weekBytes, TimeOfWeekBytes: timeOfWeekBytes, Epoch: epochSeconds(epoch), Resolution: resolution}
+}
+
+type GPSTimeConverter struct {
+	WeekBytes       int           `packed_hash_field:"week_bytes"`
+	TimeOfWeekBytes int           `packed_hash_field:"time_of_week_bytes"`
+	Epoch           int64         `packed_hash_field:"epoch"`
+	Resolution      time.Duration `packed_hash_field:"resolution"`
+	LeapSeconds     int64         `packed_hash_field:"leap_seconds"`
+}
+
+func (c GPSTimeConverter) UTC(leapSeconds int) GPSTimeConverter {
+
+	if leapSeconds < 0 {
+		panic(fmt.Sprintf("leap seconds must not be negative, got %d", leapSeconds))
+	}
+
+	c.LeapSeconds = int64(leapSeconds)
+	return c
+}
+
+func (c *GPSTimeConverter) InitializeConverterFields() map[string]string {
+	return map[string]string{
+		"WeekBytes":       fmt.Sprintf("%d", c.WeekBytes),
+		"TimeOfWeekBytes": fmt.Sprintf("%d", c.TimeOfWeekBytes),
+		"Epoch":           fmt.Sprintf("%d", c.Epoch),
+		"Resolution":      fmt.Sprintf("%d", int64(c.Resolution)),
+		"LeapSeconds":     fmt.Sprintf("%d", c.LeapSeconds),
+	}
+}
+
+func (c *GPSTimeConverter) Size() int { return c.WeekBytes + c.TimeOfWeekBytes }
+
+func (c *GPSTimeConverter) split(value *time.Time) (uint64, uint64, error) {
+
+	seconds := value.Unix() - c.Epoch + c.LeapSeconds
+	week := floorDivision(seconds, secondsPerWeek)
+	timeOfWeek := (seconds-week*secondsPerWeek)*int64(time.Second) + int64(value.Nanosecond())
+	ticks := uint64(timeOfWeek / int64(c.Resolution))
+
+	if week < 0 || uint64(week) > lowBits(8*c.WeekBytes) || ticks > lowBits(8*c.TimeOfWeekBytes) {
+		return 0, 0, fmt.Errorf("%w: %s is out of range for gps week time", ErrOverflow, value)
+	}
+
+	return uint64(week), ticks, nil
+}
+
+func (c *GPSTimeConverter) time(week, ticks uint64) (time.Time, error) {
+
+	if ticks >= uint64(7*24*time.Hour/c.Resolution) {
+		return time.Time{}, fmt.Errorf("%w: time of week %d is a week or longer", ErrInvalidValue, ticks)
+	}
+
+	if week > uint64(maxUnixSeconds-c.Epoch+c.LeapSeconds)/secondsPerWeek-1 {
+		return time.Time{}, fmt.Errorf("%w: week %d is out of range for time values", ErrOverflow, week)
+	}
+
+	timeOfWeek := time.Duration(ticks) * c.Resolution
+	return time.Unix(c.Epoch+int64(week)*secondsPerWeek-c.LeapSeconds, 0).Add(timeOfWeek).UTC(), nil
+}
+
+func (c *GPSTimeConverter) ToBytesLittleEndian(value *time.Time, bytes []byte, index int) error {
+	week, ticks, err := c.split(value)
+	if err != nil {
+		return err
+	}
+	putInteger(week, c.WeekBytes, bytes, index, true)
+	putInteger(ticks, c.TimeOfWeekBytes, bytes, index+c.WeekBytes, true)
+	return nil
+}
+
+func (c *GPSTimeConverter) FromBytesLittleEndian(reciever *time.Time, bytes []byte, index int) error {
+	value, err := c.time(getInteger(c.WeekBytes, bytes, index, true), getInteger(c.TimeOfWeekBytes, bytes, index+c.WeekBytes, true))
+	if err != nil {
+		return err
+	}
+	*reciever = value
+	return nil
+}
+
+func (c *GPSTimeConverter) ToBytesBigEndian(value *time.Time, bytes []byte, index int) error {
+	week, ticks, err := c.split(value)
+	if err != nil {
+		return err
+	}
+	putInteger(week, c.WeekBytes, bytes, index, false)
+	putInteger(ticks, c.TimeOfWeekBytes, bytes, index+c.WeekBytes, false)
+	return nil
+}
+
+func (c *GPSTimeConverter) FromBytesBigEndian(reciever *time.Time, bytes []byte, index int) error {
+	value, err := c.time(getInteger(c.WeekBytes, bytes, index, false), getInteger(c.TimeOfWeekBytes, bytes, index+c.WeekBytes, false))
+	if err != nil {
+		return err
+	}
+	*reciever = value
+	return nil
+}
+
+func DOSTimestamp(epochYear int) DOSTimestampConverter {
+	return DOSTimestampConverter{EpochYear: epochYear}
+}
+
+type DOSTimestampConverter struct {
+	EpochYear int `packed_hash_field:"epoch_year"`
+}
+
+func (c *DOSTimestampConverter) InitializeConverterFields() map[string]string {
+	return map[string]string{"EpochYear": fmt.Sprintf("%d", c.EpochYear)}
+}
+
+func (c *DOSTimestampConverter) Size() int { return 4 }
+
+func (c *DOSTimestampConverter) integer(value *time.Time) (uint64, error) {
+
+	year, month, day := value.Date()
+	hour, minute, second := value.Clock()
+
+	if year < c.EpochYear || year > c.EpochYear+127 {
+		return 0, fmt.Errorf("%w: %s is out of range for dos timestamps", ErrOverflow, value)
+	}
+
+	date := uint64(year-c.EpochYear)<<9 | uint64(month)<<5 | uint64(day)
+	clock := uint64(hour)<<11 | uint64(minute)<<5 | uint64(second/2)
+
+	return date<<16 | clock, nil
+}
+
+func (c *DOSTimestampConverter) time(integer uint64) (time.Time, error) {
+
+	date, clock := int(integer>>16), int(integer&0xFFFF)
+
+	year, month, day := c.EpochYear+date>>9, time.Month(date>>5&0xF), date&0x1F
+	hour, minute, second := clock>>11, clock>>5&0x3F, clock&0x1F*2
+
+	value := time.Date(year, month, day, hour, minute, second, 0, time.UTC)
+
+	if value.Month() != month || value.Day() != day || value.Hour() != hour || value.Minute() != minute || value.Second() != second {
+		return time.Time{}, fmt.Errorf("%w: %#08x is not a valid dos timestamp", ErrInvalidValue, integer)
+	}
+
+	return value, nil
+}
+
+func (c *DOSTimestampConverter) ToBytesLittleEndian(value *time.Time, bytes []byte, index int) error {
+	integer, err := c.integer(value)
+	if err != nil {
+		return err
+	}
+	putInteger(integer, 4, bytes, index, true)
+	return nil
+}
+
+func (c *DOSTimestampConverter) FromBytesLittleEndian(reciever *time.Time, bytes []byte, index int) error {
+	value, err := c.time(getInteger(4, bytes, index, true))
+	if err != nil {
+		return err
+	}
+	*reciever = value
+	return nil
+}
+
+func (c *DOSTimestampConverter) ToBytesBigEndian(value *time.Time, bytes []byte, index int) error {
+	integer, err := c.integer(value)
+	if err != nil {
+		return err
+	}
+	putInteger(integer, 4, bytes, index, false)
+	return nil
+}
+
+func (c *DOSTimestampConverter) FromBytesBigEndian(reciever *time.Time, bytes []byte, index int) error {
+	value, err := c.time(getInteger(4, bytes, index, false))
+	if err != nil {
+		return err
+	}
+	*reciever = value
+	return nil
+}
@@ -0,0 +1,301 @@
+package packed
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestTimestampConverter(t *testing.T) {
+	moment := time.Date(2024, time.February, 29, 12, 34, 56, 789123456, time.UTC)
+
+	cases := []struct {
+		name      string
+		converter TimestampConverter
+		expected  time.Time
+		bytes     []byte
+	}{
+		{"UnixSeconds32", UnixSeconds32, moment.Truncate(time.Second), []byte{0x65, 0xE0, 0x79, 0xF0}},
+		{"UnixSeconds64", UnixSeconds64, moment.Truncate(time.Second), []byte{0x00, 0x00, 0x00, 0x00, 0x65, 0xE0, 0x79, 0xF0}},
+		{"UnixMillis", UnixMillis, moment.Truncate(time.Millisecond), []byte{0x00, 0x00, 0x01, 0x8D, 0xF4, 0xDC, 0x54, 0x95}},
+		{"UnixNanos", UnixNanos, moment, []byte{0x17, 0xB8, 0x55, 0x86, 0xF9, 0xA0, 0x71, 0x80}},
+		{"FileTime", FileTime, moment.Truncate(100 * time.Nanosecond), []byte{0x01, 0xDA, 0x6B, 0x0B, 0xB3, 0xE6, 0x81, 0x22}},
+	}
+
+	for _, c := range cases {
+		bytes := make([]byte, c.converter.Size())
+
+		if err := c.converter.ToBytesBigEndian(&moment, bytes, 0); err != nil {
+			t.Fatalf("%s: unexpected error %v", c.name, err)
+		}
+
+		if !slices.Equal(bytes, c.bytes) {
+			t.Errorf("%s ToBytesBigEndian: expected %x, got %x", c.name, c.bytes, bytes)
+		}
+
+		var result time.Time
+		c.converter.FromBytesBigEndian(&result, bytes, 0)
+		if !result.Equal(c.expected) {
+			t.Errorf("%s FromBytesBigEndian: expected %s, got %s", c.name, c.expected, result)
+		}
+
+		if err := c.converter.ToBytesLittleEndian(&moment, bytes, 0); err != nil {
+			t.Fatalf("%s: unexpected error %v", c.name, err)
+		}
+
+		c.converter.FromBytesLittleEndian(&result, bytes, 0)
+		if !result.Equal(c.expected) {
+			t.Errorf("%s FromBytesLittleEndian: expected %s, got %s", c.name, c.expected, result)
+		}
+	}
+
+	before := time.Date(1969, time.December, 31, 23, 59, 59, 500000000, time.UTC)
+	bytes := make([]byte, 8)
+
+	if err := UnixMillis.ToBytesBigEndian(&before, bytes, 0); err != nil || !slices.Equal(bytes, []byte{0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFE, 0x0C}) {
+		t.Errorf("UnixMillis before epoch: got %x, %v", bytes, err)
+	}
+
+	var result time.Time
+	UnixMillis.FromBytesBigEndian(&result, bytes, 0)
+	if !result.Equal(before) {
+		t.Errorf("UnixMillis before epoch: expected %s, got %s", before, result)
+	}
+
+	for _, overflow := range []time.Time{time.Date(2038, time.January, 19, 3, 14, 8, 0, time.UTC), time.Date(1901, time.December, 13, 20, 45, 51, 0, time.UTC)} {
+		if err := UnixSeconds32.ToBytesBigEndian(&overflow, bytes, 0); !errors.Is(err, ErrOverflow) {
+			t.Errorf("UnixSeconds32 %s: expected overflow, got %v", overflow, err)
+		}
+	}
+
+	unsigned := Timestamp(4, false, unixEpoch, time.Second)
+
+	if err := unsigned.ToBytesBigEndian(&before, bytes, 0); !errors.Is(err, ErrOverflow) {
+		t.Errorf("unsigned timestamp before epoch: expected overflow, got %v", err)
+	}
+
+	latest := time.Date(2106, time.February, 7, 6, 28, 15, 0, time.UTC)
+	if err := unsigned.ToBytesBigEndian(&latest, bytes, 0); err != nil || !slices.Equal(bytes[:4], []byte{0xFF, 0xFF, 0xFF, 0xFF}) {
+		t.Errorf("unsigned timestamp %s: got %x, %v", latest, bytes[:4], err)
+	}
+
+	minutes := Timestamp(2, false, time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC), time.Minute)
+	value := time.Date(2000, time.January, 1, 1, 2, 59, 0, time.UTC)
+
+	if err := minutes.ToBytesBigEndian(&value, bytes, 0); err != nil || !slices.Equal(bytes[:2], []byte{0x00, 0x3E}) {
+		t.Errorf("minute timestamp %s: got %x, %v", value, bytes[:2], err)
+	}
+
+	maximum := []byte{0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF}
+
+	for name, converter := range map[string]TimestampConverter{
+		"unsigned seconds": Timestamp(8, false, unixEpoch, time.Second),
+		"signed hours":     Timestamp(8, true, unixEpoch, time.Hour),
+		"unsigned hours":   Timestamp(8, false, unixEpoch, time.Hour),
+	} {
+		result = time.Time{}
+		bytes := slices.Clone(maximum)
+
+		if name == "signed hours" {
+			bytes[0] = 0x7F
+		}
+
+		if err := converter.FromBytesBigEndian(&result, bytes, 0); !errors.Is(err, ErrOverflow) || !result.IsZero() {
+			t.Errorf("%s: expected overflow, got %s, %v", name, result, err)
+		}
+	}
+
+	if err := UnixSeconds64.FromBytesBigEndian(&result, []byte{0x7F, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF}, 0); !errors.Is(err, ErrOverflow) {
+		t.Errorf("UnixSeconds64 maximum: expected overflow, got %s, %v", result, err)
+	}
+}
+
+func TestDurationConverter(t *testing.T) {
+	converter := Duration(4, false, time.Millisecond)
+
+	value := 90*time.Second + 1500*time.Microsecond
+	bytes := make([]byte, 4)
+
+	if err := converter.ToBytesBigEndian(&value, bytes, 0); err != nil || !slices.Equal(bytes, []byte{0x00, 0x01, 0x5F, 0x91}) {
+		t.Errorf("Duration %s: got %x, %v", value, bytes, err)
+	}
+
+	var result time.Duration
+	converter.FromBytesBigEndian(&result, bytes, 0)
+	if result != 90001*time.Millisecond {
+		t.Errorf("Duration FromBytesBigEndian: got %s", result)
+	}
+
+	negative := -time.Second
+	if err := converter.ToBytesLittleEndian(&negative, bytes, 0); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Duration %s: expected overflow, got %v", negative, err)
+	}
+
+	signed := Duration(2, true, time.Second)
+
+	if err := signed.ToBytesLittleEndian(&negative, bytes, 0); err != nil {
+		t.Errorf("Duration %s: unexpected error %v", negative, err)
+	}
+
+	signed.FromBytesLittleEndian(&result, bytes, 0)
+	if result != negative {
+		t.Errorf("Duration FromBytesLittleEndian: expected %s, got %s", negative, result)
+	}
+
+	large := 32768 * time.Second
+	if err := signed.ToBytesLittleEndian(&large, bytes, 0); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Duration %s: expected overflow, got %v", large, err)
+	}
+
+	result = 0
+	nanoseconds, hours := Duration(8, false, time.Nanosecond), Duration(4, true, time.Hour)
+
+	if err := nanoseconds.FromBytesLittleEndian(&result, []byte{0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF}, 0); !errors.Is(err, ErrOverflow) || result != 0 {
+		t.Errorf("Duration unsigned maximum: expected overflow, got %s, %v", result, err)
+	}
+
+	if err := hours.FromBytesLittleEndian(&result, []byte{0x00, 0x00, 0x00, 0x80}, 0); !errors.Is(err, ErrOverflow) || result != 0 {
+		t.Errorf("Duration signed minimum: expected overflow, got %s, %v", result, err)
+	}
+
+	if err := hours.FromBytesLittleEndian(&result, []byte{0x00, 0x00, 0x01, 0x00}, 0); err != nil || result != 65536*time.Hour {
+		t.Errorf("Duration signed hours: got %s, %v", result, err)
+	}
+}
+
+func TestNTPConverter(t *testing.T) {
+	moment := time.Date(2024, time.February, 29, 12, 34, 56, 500000000, time.UTC)
+	bytes := make([]byte, 8)
+
+	if err := NTPTime.ToBytesBigEndian(&moment, bytes, 0); err != nil || !slices.Equal(bytes, []byte{0xE9, 0x8A, 0xF8, 0x70, 0x80, 0x00, 0x00, 0x00}) {
+		t.Errorf("NTPTime %s: got %x, %v", moment, bytes, err)
+	}
+
+	for nanosecond := 0; nanosecond < int(time.Second); nanosecond += 999983 {
+		value := moment.Add(time.Duration(nanosecond))
+
+		if err := NTPTime.ToBytesLittleEndian(&value, bytes, 0); err != nil {
+			t.Fatalf("NTPTime %s: unexpected error %v", value, err)
+		}
+
+		var result time.Time
+		NTPTime.FromBytesLittleEndian(&result, bytes, 0)
+		if !result.Equal(value) {
+			t.Errorf("NTPTime %s: got %s", value, result)
+		}
+	}
+
+	var result time.Time
+	NTPTime.FromBytesBigEndian(&result, []byte{0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF}, 0)
+	if expected := time.Date(2036, time.February, 7, 6, 28, 16, 0, time.UTC); !result.Equal(expected) {
+		t.Errorf("NTPTime maximum: expected %s, got %s", expected, result)
+	}
+
+	era := time.Date(2036, time.February, 7, 6, 28, 16, 0, time.UTC)
+	if err := NTPTime.ToBytesBigEndian(&era, bytes, 0); !errors.Is(err, ErrOverflow) {
+		t.Errorf("NTPTime %s: expected overflow, got %v", era, err)
+	}
+
+	nextEra := NTPTimestamp(era)
+
+	if err := nextEra.ToBytesBigEndian(&era, bytes, 0); err != nil || !slices.Equal(bytes, make([]byte, 8)) {
+		t.Errorf("NTPTimestamp era 1 %s: got %x, %v", era, bytes, err)
+	}
+}
+
+func TestGPSTimeConverter(t *testing.T) {
+	moment := time.Date(2024, time.February, 29, 12, 34, 56, 789000000, time.UTC)
+	bytes := make([]byte, GPSTime.Size())
+
+	if err := GPSTime.ToBytesBigEndian(&moment, bytes, 0); err != nil || !slices.Equal(bytes, []byte{0x08, 0xFF, 0x17, 0x4C, 0x9C, 0x95}) {
+		t.Errorf("GPSTime %s: got %x, %v", moment, bytes, err)
+	}
+
+	var result time.Time
+	GPSTime.FromBytesBigEndian(&result, bytes, 0)
+	if !result.Equal(moment) {
+		t.Errorf("GPSTime FromBytesBigEndian: expected %s, got %s", moment, result)
+	}
+
+	GPSTime.ToBytesLittleEndian(&moment, bytes, 0)
+	GPSTime.FromBytesLittleEndian(&result, bytes, 0)
+	if !result.Equal(moment) {
+		t.Errorf("GPSTime FromBytesLittleEndian: expected %s, got %s", moment, result)
+	}
+
+	before := gpsEpoch.Add(-time.Second)
+	if err := GPSTime.ToBytesBigEndian(&before, bytes, 0); !errors.Is(err, ErrOverflow) {
+		t.Errorf("GPSTime %s: expected overflow, got %v", before, err)
+	}
+
+	utc := GPSTime.UTC(18)
+
+	if err := utc.ToBytesBigEndian(&moment, bytes, 0); err != nil || !slices.Equal(bytes, []byte{0x08, 0xFF, 0x17, 0x4C, 0xE2, 0xE5}) {
+		t.Errorf("GPSTime UTC %s: got %x, %v", moment, bytes, err)
+	}
+
+	utc.FromBytesBigEndian(&result, bytes, 0)
+	if !result.Equal(moment) {
+		t.Errorf("GPSTime UTC FromBytesBigEndian: expected %s, got %s", moment, result)
+	}
+
+	rollover := GPSWeekTime(1, 4, gpsEpoch, time.Second)
+	late := gpsEpoch.Add(256 * 7 * 24 * time.Hour)
+	if err := rollover.ToBytesBigEndian(&late, make([]byte, 5), 0); !errors.Is(err, ErrOverflow) {
+		t.Errorf("GPSWeekTime %s: expected overflow, got %v", late, err)
+	}
+
+	result = time.Time{}
+	putInteger(7*24*60*60*1000, 4, bytes, 2, false)
+
+	if err := GPSTime.FromBytesBigEndian(&result, bytes, 0); !errors.Is(err, ErrInvalidValue) || !result.IsZero() {
+		t.Errorf("GPSTime full week: expected invalid value, got %s, %v", result, err)
+	}
+
+	wide := GPSWeekTime(8, 4, gpsEpoch, time.Second)
+	if err := wide.FromBytesBigEndian(&result, []byte{0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0, 0, 0, 0}, 0); !errors.Is(err, ErrOverflow) {
+		t.Errorf("GPSWeekTime maximum week: expected overflow, got %s, %v", result, err)
+	}
+}
+
+func TestDOSTimestampConverter(t *testing.T) {
+	moment := time.Date(2024, time.February, 29, 12, 34, 57, 0, time.UTC)
+	bytes := make([]byte, 4)
+
+	if err := DOSDateTime.ToBytesLittleEndian(&moment, bytes, 0); err != nil || !slices.Equal(bytes, []byte{0x5C, 0x64, 0x5D, 0x58}) {
+		t.Errorf("DOSDateTime %s: got %x, %v", moment, bytes, err)
+	}
+
+	var result time.Time
+	DOSDateTime.FromBytesLittleEndian(&result, bytes, 0)
+	if expected := moment.Add(-time.Second); !result.Equal(expected) {
+		t.Errorf("DOSDateTime FromBytesLittleEndian: expected %s, got %s", expected, result)
+	}
+
+	for _, overflow := range []time.Time{time.Date(1979, time.December, 31, 0, 0, 0, 0, time.UTC), time.Date(2108, time.January, 1, 0, 0, 0, 0, time.UTC)} {
+		if err := DOSDateTime.ToBytesBigEndian(&overflow, bytes, 0); !errors.Is(err, ErrOverflow) {
+			t.Errorf("DOSDateTime %s: expected overflow, got %v", overflow, err)
+		}
+	}
+
+	date := uint64(44<<9 | 1<<5 | 1)
+
+	invalid := map[string]uint64{
+		"zero":        0,
+		"month 13":    uint64(44<<9|13<<5|1) << 16,
+		"february 30": uint64(44<<9|2<<5|30) << 16,
+		"hour 24":     date<<16 | 24<<11,
+		"minute 60":   date<<16 | 60<<5,
+		"second 60":   date<<16 | 30,
+	}
+
+	for name, integer := range invalid {
+		result = time.Time{}
+		putInteger(integer, 4, bytes, 0, true)
+
+		if err := DOSDateTime.FromBytesLittleEndian(&result, bytes, 0); !errors.Is(err, ErrInvalidValue) || !result.IsZero() {
+			t.Errorf("DOSDateTime %s: expected invalid value, got %s, %v", name, result, err)
+		}
+	}
+}
@@ -1,9 +1,7 @@
 package packed
 
 import (
-	"fmt"
 	"math"
-	"strings"
 )
 
 var (
@@ -325,35 +323,3 @@ func (Float64Converter) FromBytesBigEndian(receiver *float64, bytes []byte, inde
 	v := uint64(bytes[index])<<56 | uint64(bytes[index+1])<<48 | uint64(bytes[index+2])<<40 | uint64(bytes[index+3])<<32 | uint64(bytes[index+4])<<24 | uint64(bytes[index+5])<<16 | uint64(bytes[index+6])<<8 | uint64(bytes[index+7])
 	*receiver = math.Float64frombits(v)
 }
-
-func String(length int) StringConverter {
-	return StringConverter{Length: length}
-}
-
-type StringConverter struct {
-	Length int `packed_hash_field:"length"`
-}
-
-func (s *StringConverter) InitializeConverterFields() map[string]string {
-	return map[string]string{
-		"Length": fmt.Sprintf("%v", s.Length),
-	}
-}
-
-func (s *StringConverter) Size() int { return s.Length }
-
-func (s *StringConverter) ToBytesLittleEndian(value *string, bytes []byte, index int) {
-	copy(bytes[index:index+s.Length], []byte(*value))
-}
-
-func (s *StringConverter) FromBytesLittleEndian(receiver *string, bytes []byte, index int) {
-	*receiver = strings.TrimRight(string(bytes[index:index+s.Length]), "\x00")
-}
-
-func (s *StringConverter) ToBytesBigEndian(value *string, bytes []byte, index int) {
-	copy(bytes[index:index+s.Length], []byte(*value))
-}
-
-func (s *StringConverter) FromBytesBigEndian(receiver *string, bytes []byte, index int) {
-	*receiver = strings.TrimRight(string(bytes[index:index+s.Length]), "\x00")
-}
@@ -2,7 +2,11 @@ package packed
 
 import (
 	"math"
+	"reflect"
+	"slices"
 	"testing"
+
+	"golang.org/x/exp/constraints"
 )
 
 func TestBooleanConverter(t *testing.T) {
@@ -283,3 +287,189 @@ func TestStringConverter(t *testing.T) {
 		t.Errorf("StringConverter: expected %s, got %s", original, result)
 	}
 }
+
+func TestFixedPointConverter(t *testing.T) {
+
+	tests := []struct {
+		converter FixedPointConverter
+		value     float64
+		bytes     []byte
+		result    float64
+	}{
+		{Q15, 0.5, []byte{0x40, 0x00}, 0.5},
+		{Q15, -1, []byte{0x80, 0x00}, -1},
+		{Q15, 1, []byte{0x7F, 0xFF}, 32767.0 / 32768},
+		{Q15, -2, []byte{0x80, 0x00}, -1},
+		{FixedPoint(32, 16, true), -1.5, []byte{0xFF, 0xFE, 0x80, 0x00}, -1.5},
+		{FixedPoint(16, 4, false), 2.03, []byte{0x00, 0x20}, 2},
+		{FixedPoint(16, 4, false), 2.04, []byte{0x00, 0x21}, 2.0625},
+		{FixedPoint(16, 4, false), -3, []byte{0x00, 0x00}, 0},
+		{Decimal(16, 2, true), 12.346, []byte{0x04, 0xD3}, 12.35},
+		{Decimal(16, 2, true), -0.005, []byte{0xFF, 0xFF}, -0.01},
+		{Decimal(16, 2, true), 400, []byte{0x7F, 0xFF}, 327.67},
+		{Decimal(8, 1, false), 25.5, []byte{0xFF}, 25.5},
+		{Decimal(8, 1, false), 30, []byte{0xFF}, 25.5},
+		{FixedPoint(64, 0, true), 1e20, []byte{0x7F, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF}, math.MaxInt64},
+	}
+
+	for _, test := range tests {
+
+		converter := test.converter
+		bytes := make([]byte, converter.Size())
+
+		converter.ToBytesBigEndian(&test.value, bytes, 0)
+
+		if !reflect.DeepEqual(bytes, test.bytes) {
+			t.Errorf("FixedPoint %+v ToBytesBigEndian(%v): expected %x, got %x", converter, test.value, test.bytes, bytes)
+		}
+
+		var result float64
+		converter.FromBytesBigEndian(&result, bytes, 0)
+
+		if math.Abs(result-test.result) > 1e-9 {
+			t.Errorf("FixedPoint %+v FromBytesBigEndian: expected %v, got %v", converter, test.result, result)
+		}
+
+		converter.ToBytesLittleEndian(&test.value, bytes, 0)
+		slices.Reverse(bytes)
+
+		if !reflect.DeepEqual(bytes, test.bytes) {
+			t.Errorf("FixedPoint %+v ToBytesLittleEndian(%v): expected reversed %x, got %x", converter, test.value, test.bytes, bytes)
+		}
+	}
+
+	converter := FixedPoint(12, 4, true)
+	value := -2.5
+
+	if integer := converter.Integer(&value); integer != 0xFD8 {
+		t.Errorf("FixedPoint bits: expected 0xFD8, got 0x%X", integer)
+	}
+
+	converter.Set(&value, 0x7FF)
+
+	if value != 127.9375 {
+		t.Errorf("FixedPoint bits: expected 127.9375, got %v", value)
+	}
+
+	Bits[uint64](12, FixedPoint(12, 4, true))
+
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Errorf("FixedPoint bits: expected a panic for a 12 bit converter in 8 bits")
+			}
+		}()
+		Bits[uint64](8, FixedPoint(12, 4, true))
+	}()
+}
+
+func TestIntConverter(t *testing.T) {
+	for bytes := 1; bytes <= 8; bytes++ {
+		bits := 8 * bytes
+		maximum := int64(uint64(1)<<(bits-1) - 1)
+		minimum := -maximum - 1
+
+		for _, original := range []int64{0, 1, -1, maximum, minimum, maximum / 3, minimum / 5} {
+			var resultLittleEndian, resultBigEndian int64
+
+			switch converter := Int(bytes).(type) {
+			case IntConverter[int8]:
+				resultLittleEndian, resultBigEndian = intRoundTrip(t, &converter, int8(original))
+			case IntConverter[int16]:
+				resultLittleEndian, resultBigEndian = intRoundTrip(t, &converter, int16(original))
+			case IntConverter[int32]:
+				resultLittleEndian, resultBigEndian = intRoundTrip(t, &converter, int32(original))
+			case IntConverter[int64]:
+				resultLittleEndian, resultBigEndian = intRoundTrip(t, &converter, original)
+			default:
+				t.Fatalf("Int(%d): unexpected converter %T", bytes, converter)
+			}
+
+			if resultLittleEndian != original || resultBigEndian != original {
+				t.Errorf("Int(%d) %d: got %d and %d", bytes, original, resultLittleEndian, resultBigEndian)
+			}
+		}
+	}
+
+	converter := Int(3).(IntConverter[int32])
+	buffer := []byte{0x00, 0x00, 0x80, 0xFF}
+	var result int32
+
+	converter.FromBytesLittleEndian(&result, buffer, 0)
+	if result != -8388608 {
+		t.Errorf("Int(3) FromBytesLittleEndian: expected -8388608, got %d", result)
+	}
+
+	converter.FromBytesBigEndian(&result, buffer, 1)
+	if result != 33023 {
+		t.Errorf("Int(3) FromBytesBigEndian: expected 33023, got %d", result)
+	}
+}
+
+func intRoundTrip[Integer constraints.Signed](t *testing.T, converter ConverterInterface[Integer], original Integer) (int64, int64) {
+	bytes := make([]byte, converter.Size()+1)
+
+	converter.ToBytesLittleEndian(&original, bytes, 1)
+	var resultLittleEndian Integer
+	converter.FromBytesLittleEndian(&resultLittleEndian, bytes, 1)
+
+	converter.ToBytesBigEndian(&original, bytes, 1)
+	var resultBigEndian Integer
+	converter.FromBytesBigEndian(&resultBigEndian, bytes, 1)
+
+	if bytes[0] != 0 {
+		t.Errorf("%T wrote outside of its bytes", converter)
+	}
+
+	return int64(resultLittleEndian), int64(resultBigEndian)
+}
+
+func TestUintConverter(t *testing.T) {
+	expected := map[int]string{1: "uint8", 2: "uint16", 3: "uint32", 4: "uint32", 5: "uint64", 6: "uint64", 7: "uint64", 8: "uint64"}
+
+	for bytes := 1; bytes <= 8; bytes++ {
+		converter := Uint(bytes)
+
+		reciever, _ := implementsConverterInterface(toPointer(converter))
+		if reciever.String() != expected[bytes] {
+			t.Errorf("Uint(%d): expected %s, got %s", bytes, expected[bytes], reciever)
+		}
+
+		if converter, ok := converter.(UintConverter[uint64]); ok {
+			maximum := uint64(1)<<(8*bytes) - 1
+			if bytes == 8 {
+				maximum = math.MaxUint64
+			}
+
+			for _, original := range []uint64{0, 1, maximum, maximum / 3} {
+				buffer := make([]byte, bytes)
+
+				converter.ToBytesLittleEndian(&original, buffer, 0)
+				var resultLittleEndian uint64
+				converter.FromBytesLittleEndian(&resultLittleEndian, buffer, 0)
+
+				converter.ToBytesBigEndian(&original, buffer, 0)
+				var resultBigEndian uint64
+				converter.FromBytesBigEndian(&resultBigEndian, buffer, 0)
+
+				if resultLittleEndian != original || resultBigEndian != original {
+					t.Errorf("Uint(%d) %d: got %d and %d", bytes, original, resultLittleEndian, resultBigEndian)
+				}
+			}
+		}
+	}
+
+	converter := Uint(3).(UintConverter[uint32])
+	original := uint32(0x123456)
+	buffer := make([]byte, 3)
+
+	converter.ToBytesBigEndian(&original, buffer, 0)
+	if !slices.Equal(buffer, []byte{0x12, 0x34, 0x56}) {
+		t.Errorf("Uint(3) ToBytesBigEndian: got %x", buffer)
+	}
+
+	converter.ToBytesLittleEndian(&original, buffer, 0)
+	if !slices.Equal(buffer, []byte{0x56, 0x34, 0x12}) {
+		t.Errorf("Uint(3) ToBytesLittleEndian: got %x", buffer)
+	}
+}
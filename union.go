@@ -0,0 +1,232 @@
+package packed
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+type unionVariant struct {
+	value     string
+	integer   reflect.Value
+	structure packedStruct
+}
+
+type packedUnion struct {
+	name               string
+	discriminatorField string
+	discriminatorType  string
+	variants           []unionVariant
+	fixed              bool
+	size               int
+}
+
+func (u packedUnion) Size() int { return u.size }
+
+func Variant(value any, structure packedStruct) unionVariant {
+
+	integer := reflect.ValueOf(value)
+
+	switch {
+	case integer.CanInt():
+		return unionVariant{value: strconv.FormatInt(integer.Int(), 10), integer: integer, structure: structure}
+	case integer.CanUint():
+		return unionVariant{value: strconv.FormatUint(integer.Uint(), 10), integer: integer, structure: structure}
+	}
+
+	panic(fmt.Sprintf("union variant value %v is not an integer", value))
+}
+
+func fitsInteger(value reflect.Value, reflection reflect.Type, maximum uint64) bool {
+
+	if !value.CanInt() {
+		return value.Uint() <= maximum
+	}
+
+	integer := value.Int()
+
+	if integer >= 0 {
+		return uint64(integer) <= maximum
+	}
+
+	return reflection.Kind() >= reflect.Int && reflection.Kind() <= reflect.Int64 && uint64(-(integer+1)) <= maximum
+}
+
+func createUnion(discriminatorField string, variants []unionVariant, fixed bool) packedUnion {
+
+	if len(variants) == 0 {
+		panic("union must have at least one variant")
+	}
+
+	union := packedUnion{discriminatorField: discriminatorField, variants: variants, fixed: fixed}
+	values := map[string]bool{}
+
+	for _, variant := range variants {
+
+		if values[variant.value] {
+			panic(fmt.Sprintf("union variant value %s already exists", variant.value))
+		}
+
+		values[variant.value] = true
+
+		if fixed && variant.structure.variable {
+			panic(fmt.Sprintf("variable size struct %s cannot be a variant of a fixed size union", variant.structure.name))
+		}
+
+		if fixed && variant.structure.size > union.size {
+			union.size = variant.structure.size
+		}
+	}
+
+	return union
+}
+
+func Union(discriminatorField string, variants ...unionVariant) packedUnion {
+	return createUnion(discriminatorField, variants, false)
+}
+
+func FixedUnion(discriminatorField string, variants ...unionVariant) packedUnion {
+	return createUnion(discriminatorField, variants, true)
+}
+
+func (u *packedUnion) resolveDiscriminator(properties []packedProperty, name string) {
+
+	property, ok := findProperty(properties, u.discriminatorField)
+
+	if !ok {
+		panic(fmt.Sprintf("discriminator field %s does not exist before the union", u.discriminatorField))
+	}
+
+	reflection, typeName, maximum, ok := integerProperty(property)
+
+	if !ok {
+		panic(fmt.Sprintf("discriminator field %s is not an integer", u.discriminatorField))
+	}
+
+	for _, variant := range u.variants {
+		if !fitsInteger(variant.integer, reflection, maximum) {
+			panic(fmt.Sprintf("union variant value %s does not fit in discriminator field %s", variant.value, u.discriminatorField))
+		}
+	}
+
+	if _, ok := structs[name]; ok {
+		panic(fmt.Sprintf("union %s conflicts with a struct of the same name", name))
+	}
+
+	if _, ok := enums[name]; ok {
+		panic(fmt.Sprintf("union %s conflicts with an enum of the same name", name))
+	}
+
+	if _, ok := flagSets[name]; ok {
+		panic(fmt.Sprintf("union %s conflicts with flags of the same name", name))
+	}
+
+	if _, ok := fixedStrings[name]; ok {
+		panic(fmt.Sprintf("union %s conflicts with a fixed string of the same name", name))
+	}
+
+	unions[name] = true
+
+	u.name = name
+	u.discriminatorType = typeName
+}
+
+func (u packedUnion) interfaceDefinition() []byte {
+
+	buffer := &bytes.Buffer{}
+
+	fmt.Fprintf(buffer, "type %s interface {\n", u.name)
+	fmt.Fprintf(buffer, "Size() int\n")
+	fmt.Fprintf(buffer, "ToBytes(bytes []byte, index int) (int, error)\n")
+	fmt.Fprintf(buffer, "FromBytes(bytes []byte, index int) (int, error)\n")
+
+	if u.validated() {
+		fmt.Fprintf(buffer, "Validate() error\n")
+	}
+
+	fmt.Fprintf(buffer, "is%s()\n", u.name)
+	fmt.Fprintf(buffer, "}\n\n")
+
+	for _, variant := range u.variants {
+		fmt.Fprintf(buffer, "func (*%s) is%s() {}\n\n", variant.structure.name, u.name)
+	}
+
+	return buffer.Bytes()
+}
+
+func (u packedUnion) writePrepare(buffer *bytes.Buffer, structure *packedStruct, reciever string, discriminatorReciever string) {
+
+	fmt.Fprintf(buffer, "switch %s.(type) {\n", reciever)
+
+	for _, variant := range u.variants {
+		fmt.Fprintf(buffer, "case *%s:\n", variant.structure.name)
+		fmt.Fprintf(buffer, "%s = %s(%s)\n", discriminatorReciever, u.discriminatorType, variant.value)
+	}
+
+	fmt.Fprintf(buffer, "default:\n")
+	fmt.Fprintf(buffer, "return 0, &packed.FieldError{Path: %q, Err: packed.ErrUnknownVariant}\n", fieldPath(structure, reciever))
+	fmt.Fprintf(buffer, "}\n")
+}
+
+func (u packedUnion) writeSize(buffer *bytes.Buffer, reciever string) {
+	fmt.Fprintf(buffer, "if %s != nil {\n", reciever)
+	fmt.Fprintf(buffer, "size += %s.Size()\n", reciever)
+	fmt.Fprintf(buffer, "}\n")
+}
+
+func (u packedUnion) write(buffer *bytes.Buffer, structure *packedStruct, reciever string, discriminatorReciever string, functionName string, offset *propertyOffset) {
+
+	path := fieldPath(structure, reciever)
+
+	if !u.fixed {
+		offset.flush(buffer)
+	}
+
+	if functionName == "FromBytes" {
+		fmt.Fprintf(buffer, "switch %s {\n", discriminatorReciever)
+
+		for _, variant := range u.variants {
+			fmt.Fprintf(buffer, "case %s:\n", variant.value)
+			fmt.Fprintf(buffer, "%s = new(%s)\n", reciever, variant.structure.name)
+		}
+
+		fmt.Fprintf(buffer, "default:\n")
+		fmt.Fprintf(buffer, "return 0, &packed.FieldError{Path: %q, Err: packed.ErrUnknownVariant}\n", path)
+		fmt.Fprintf(buffer, "}\n")
+	}
+
+	call := fmt.Sprintf("%s.%s(bytes, index + %d)", reciever, functionName, offset.constant)
+
+	switch {
+
+	case !u.fixed:
+		fmt.Fprintf(buffer, "if n, err := %s; err != nil {\n", call)
+		fmt.Fprintf(buffer, "return 0, &packed.FieldError{Path: %q, Err: err}\n", path)
+		fmt.Fprintf(buffer, "} else {\n")
+		fmt.Fprintf(buffer, "index += n\n")
+
+	case functionName == "ToBytes":
+		fmt.Fprintf(buffer, "if n, err := %s; err != nil {\n", call)
+		fmt.Fprintf(buffer, "return 0, &packed.FieldError{Path: %q, Err: err}\n", path)
+		fmt.Fprintf(buffer, "} else {\n")
+		fmt.Fprintf(buffer, "clear(bytes[index+%d+n : index+%d])\n", offset.constant, offset.constant+u.size)
+
+	default:
+		fmt.Fprintf(buffer, "if _, err := %s; err != nil {\n", call)
+		fmt.Fprintf(buffer, "return 0, &packed.FieldError{Path: %q, Err: err}\n", path)
+	}
+
+	fmt.Fprintf(buffer, "}\n")
+
+	if u.fixed {
+		offset.add(u.size)
+		return
+	}
+
+	if functionName == "FromBytes" {
+		fmt.Fprintf(buffer, "if len(bytes)-index < %d {\n", offset.remaining(structure))
+		fmt.Fprintf(buffer, "return 0, &packed.FieldError{Path: %q, Err: packed.ErrShortBuffer}\n", path)
+		fmt.Fprintf(buffer, "}\n")
+	}
+}
@@ -0,0 +1,79 @@
+package packed
+
+import "testing"
+
+type namedKind uint8
+
+func (k namedKind) String() string { return "named" }
+
+func TestVariantValue(t *testing.T) {
+
+	variant := Struct("VariantValueA", false, Field("A", Uint8))
+
+	if value := Variant(namedKind(2), variant).value; value != "2" {
+		t.Errorf("expected the integer value 2, got %s", value)
+	}
+
+	if value := Variant(int8(-3), variant).value; value != "-3" {
+		t.Errorf("expected the integer value -3, got %s", value)
+	}
+}
+
+func TestVariantInvalid(t *testing.T) {
+
+	variant := Struct("VariantInvalidA", false, Field("A", Uint8))
+
+	tests := map[string]func(){
+		"not an integer": func() { Variant("a", variant) },
+		"too wide": func() {
+			Struct("VariantTooWide", false, Field("Type", Bits[uint8](2)), Field("Reserved", Bits[uint8](6)), Field("Payload", Union("Type", Variant(4, variant))))
+		},
+		"negative unsigned": func() {
+			Struct("VariantNegative", false, Field("Type", Uint8), Field("Payload", Union("Type", Variant(-1, variant))))
+		},
+		"too small": func() {
+			Struct("VariantTooSmall", false, Field("Type", Int8), Field("Payload", Union("Type", Variant(-129, variant))))
+		},
+	}
+
+	for name, test := range tests {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s: expected a panic", name)
+				}
+			}()
+			test()
+		}()
+	}
+
+	Struct("VariantFits", false, Field("Type", Int8), Field("Payload", Union("Type", Variant(-128, variant))))
+}
+
+func TestUnionNameConflicts(t *testing.T) {
+
+	variant := Struct("UnionConflictA", false, Field("A", Uint8))
+
+	Struct("ConflictPacketKind", false, Field("A", Uint8))
+
+	tests := map[string]func(){
+		"struct before union": func() {
+			Struct("ConflictPacket", false, Field("Type", Uint8), Field("Kind", Union("Type", Variant(1, variant))))
+		},
+		"union before struct": func() {
+			Struct("ConflictFrame", false, Field("Type", Uint8), Field("Kind", Union("Type", Variant(1, variant))))
+			Struct("ConflictFrameKind", false, Field("A", Uint8))
+		},
+	}
+
+	for name, test := range tests {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s: expected a panic", name)
+				}
+			}()
+			test()
+		}()
+	}
+}
@@ -0,0 +1,116 @@
+package packed
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+type UUIDLayout int
+
+const (
+	UUIDLayoutRFC4122 UUIDLayout = iota
+	UUIDLayoutMicrosoft
+)
+
+var (
+	RFC4122UUID   = UUIDConverter{Layout: UUIDLayoutRFC4122}
+	MicrosoftGUID = UUIDConverter{Layout: UUIDLayoutMicrosoft}
+)
+
+type UUID [16]byte
+
+func ParseUUID(value string) (UUID, error) {
+
+	var uuid UUID
+
+	text := value
+
+	if len(text) == 38 && text[0] == '{' && text[37] == '}' {
+		text = text[1:37]
+	}
+
+	if len(text) != 36 || text[8] != '-' || text[13] != '-' || text[18] != '-' || text[23] != '-' {
+		return uuid, fmt.Errorf("%w: %q is not a valid uuid", ErrInvalidValue, value)
+	}
+
+	if _, err := hex.Decode(uuid[:], []byte(strings.ReplaceAll(text, "-", ""))); err != nil {
+		return uuid, fmt.Errorf("%w: %q is not a valid uuid", ErrInvalidValue, value)
+	}
+
+	return uuid, nil
+}
+
+func (u UUID) String() string {
+
+	var text [36]byte
+
+	hex.Encode(text[0:8], u[0:4])
+	text[8] = '-'
+	hex.Encode(text[9:13], u[4:6])
+	text[13] = '-'
+	hex.Encode(text[14:18], u[6:8])
+	text[18] = '-'
+	hex.Encode(text[19:23], u[8:10])
+	text[23] = '-'
+	hex.Encode(text[24:36], u[10:16])
+
+	return string(text[:])
+}
+
+func (u UUID) MarshalText() ([]byte, error) {
+	return []byte(u.String()), nil
+}
+
+func (u *UUID) UnmarshalText(text []byte) error {
+
+	uuid, err := ParseUUID(string(text))
+
+	if err != nil {
+		return err
+	}
+
+	*u = uuid
+	return nil
+}
+
+type UUIDConverter struct {
+	Layout UUIDLayout `packed_hash_field:"layout"`
+}
+
+func (c *UUIDConverter) InitializeConverterFields() map[string]string {
+	return map[string]string{
+		"Layout": fmt.Sprintf("%d", c.Layout),
+	}
+}
+
+func (c *UUIDConverter) Size() int { return 16 }
+
+func (c *UUIDConverter) swap(bytes []byte) {
+
+	if c.Layout != UUIDLayoutMicrosoft {
+		return
+	}
+
+	bytes[0], bytes[1], bytes[2], bytes[3] = bytes[3], bytes[2], bytes[1], bytes[0]
+	bytes[4], bytes[5] = bytes[5], bytes[4]
+	bytes[6], bytes[7] = bytes[7], bytes[6]
+}
+
+func (c *UUIDConverter) ToBytesLittleEndian(value *UUID, bytes []byte, index int) {
+	copy(bytes[index:index+16], value[:])
+	c.swap(bytes[index : index+16])
+}
+
+func (c *UUIDConverter) FromBytesLittleEndian(reciever *UUID, bytes []byte, index int) {
+	copy(reciever[:], bytes[index:index+16])
+	c.swap(reciever[:])
+}
+
+func (c *UUIDConverter) ToBytesBigEndian(value *UUID, bytes []byte, index int) {
+	c.ToBytesLittleEndian(value, bytes, index)
+}
+
+func (c *UUIDConverter) FromBytesBigEndian(reciever *UUID, bytes []byte, index int) {
+	c.FromBytesLittleEndian(reciever, bytes, index)
+}
@@ -0,0 +1,93 @@
+package packed
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestUUID(t *testing.T) {
+
+	uuid, err := ParseUUID("00112233-4455-6677-8899-aabbccddeeff")
+
+	if err != nil {
+		t.Fatalf("parse: unexpected error %v", err)
+	}
+
+	expected := UUID{0x00, 0x11, 0x22, 0x33, 0x44, 0x55, 0x66, 0x77, 0x88, 0x99, 0xAA, 0xBB, 0xCC, 0xDD, 0xEE, 0xFF}
+
+	if uuid != expected {
+		t.Errorf("parse: expected %x, got %x", expected, uuid)
+	}
+
+	if uuid.String() != "00112233-4455-6677-8899-aabbccddeeff" {
+		t.Errorf("string: got %s", uuid)
+	}
+
+	braced, err := ParseUUID("{00112233-4455-6677-8899-AABBCCDDEEFF}")
+
+	if err != nil || braced != expected {
+		t.Errorf("parse braced: expected %x, got %x (%v)", expected, braced, err)
+	}
+
+	for _, invalid := range []string{"", "00112233445566778899aabbccddeeff", "00112233-4455-6677-8899-aabbccddeefg", "{00112233-4455-6677-8899-aabbccddeeff", "0011223-34455-6677-8899-aabbccddeeff"} {
+		if _, err := ParseUUID(invalid); !errors.Is(err, ErrInvalidValue) {
+			t.Errorf("parse %q: expected invalid value, got %v", invalid, err)
+		}
+	}
+
+	encoded, err := json.Marshal(map[string]UUID{"id": uuid})
+
+	if err != nil || string(encoded) != `{"id":"00112233-4455-6677-8899-aabbccddeeff"}` {
+		t.Errorf("marshal: got %s (%v)", encoded, err)
+	}
+
+	var decoded map[string]UUID
+
+	if err := json.Unmarshal(encoded, &decoded); err != nil || decoded["id"] != uuid {
+		t.Errorf("unmarshal: expected %x, got %x (%v)", uuid, decoded["id"], err)
+	}
+
+	if err := json.Unmarshal([]byte(`{"id":"nope"}`), &decoded); !errors.Is(err, ErrInvalidValue) {
+		t.Errorf("unmarshal: expected invalid value, got %v", err)
+	}
+}
+
+func TestUUIDConverter(t *testing.T) {
+
+	uuid := UUID{0x00, 0x11, 0x22, 0x33, 0x44, 0x55, 0x66, 0x77, 0x88, 0x99, 0xAA, 0xBB, 0xCC, 0xDD, 0xEE, 0xFF}
+
+	tests := []struct {
+		converter UUIDConverter
+		expected  []byte
+	}{
+		{RFC4122UUID, []byte{0x00, 0x11, 0x22, 0x33, 0x44, 0x55, 0x66, 0x77, 0x88, 0x99, 0xAA, 0xBB, 0xCC, 0xDD, 0xEE, 0xFF}},
+		{MicrosoftGUID, []byte{0x33, 0x22, 0x11, 0x00, 0x55, 0x44, 0x77, 0x66, 0x88, 0x99, 0xAA, 0xBB, 0xCC, 0xDD, 0xEE, 0xFF}},
+	}
+
+	for _, test := range tests {
+
+		for _, littleEndian := range []bool{true, false} {
+
+			bytes := make([]byte, 17)
+			var result UUID
+
+			if littleEndian {
+				test.converter.ToBytesLittleEndian(&uuid, bytes, 1)
+				test.converter.FromBytesLittleEndian(&result, bytes, 1)
+			} else {
+				test.converter.ToBytesBigEndian(&uuid, bytes, 1)
+				test.converter.FromBytesBigEndian(&result, bytes, 1)
+			}
+
+			if !reflect.DeepEqual(bytes[1:], test.expected) {
+				t.Errorf("layout %d: expected %x, got %x", test.converter.Layout, test.expected, bytes[1:])
+			}
+
+			if result != uuid {
+				t.Errorf("layout %d: expected %x, got %x", test.converter.Layout, uuid, result)
+			}
+		}
+	}
+}
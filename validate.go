@@ -30,6 +30,18 @@ func ValidateSize(converter any) bool {
 	return true
 }
 
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+func converterReturnsError(converter any, methodName string) bool {
+	method := reflect.ValueOf(converter).MethodByName(methodName)
+
+	if !method.IsValid() || method.Type().NumOut() == 0 {
+		return false
+	}
+
+	return method.Type().Out(method.Type().NumOut()-1) == errorType
+}
+
 func validateConverter(methodName string, converter any) (reflect.Type, bool) {
 	if converter == nil {
 		return nil, false
@@ -46,7 +58,11 @@ func validateConverter(methodName string, converter any) (reflect.Type, bool) {
 	}
 
 	methodType := method.Type()
-	if methodType.NumIn() != 3 || methodType.NumOut() != 0 {
+	if methodType.NumIn() != 3 || methodType.NumOut() > 1 {
+		return nil, false
+	}
+
+	if methodType.NumOut() == 1 && methodType.Out(0) != errorType {
 		return nil, false
 	}
 
@@ -93,6 +109,48 @@ func implementsConverterInterface(converter any) (reflect.Type, bool) {
 	return first, true
 }
 
+func implementsVariableConverterInterface(converter any) (reflect.Type, bool) {
+
+	if !ValidateSize(converter) {
+		return nil, false
+	}
+
+	value := reflect.ValueOf(converter)
+	intType := reflect.TypeOf(int(0))
+
+	sizeOf := value.MethodByName("SizeOf")
+	if !sizeOf.IsValid() || sizeOf.Type().NumIn() != 1 || sizeOf.Type().NumOut() != 1 || sizeOf.Type().Out(0) != intType {
+		return nil, false
+	}
+
+	reciever := sizeOf.Type().In(0)
+	if reciever.Kind() != reflect.Ptr {
+		return nil, false
+	}
+
+	for _, methodName := range []string{"ToBytes", "FromBytes"} {
+		method := value.MethodByName(methodName)
+		if !method.IsValid() {
+			return nil, false
+		}
+
+		methodType := method.Type()
+		if methodType.NumIn() != 3 || methodType.NumOut() != 2 {
+			return nil, false
+		}
+
+		if methodType.In(0) != reciever || methodType.In(1) != reflect.TypeOf([]byte{}) || methodType.In(2) != intType {
+			return nil, false
+		}
+
+		if methodType.Out(0) != intType || methodType.Out(1) != errorType {
+			return nil, false
+		}
+	}
+
+	return reciever.Elem(), true
+}
+
 func validateSetMethod(converter any, integer reflect.Type) (reflect.Type, bool) {
 	if converter == nil {
 		return nil, false
@@ -109,7 +167,11 @@ func validateSetMethod(converter any, integer reflect.Type) (reflect.Type, bool)
 	}
 
 	methodType := method.Type()
-	if methodType.NumIn() != 2 || methodType.NumOut() != 0 {
+	if methodType.NumIn() != 2 || methodType.NumOut() > 1 {
+		return nil, false
+	}
+
+	if methodType.NumOut() == 1 && methodType.Out(0) != errorType {
 		return nil, false
 	}
 
@@ -141,7 +203,11 @@ func validateIntegerMethod(converter any, integer reflect.Type) (reflect.Type, b
 	}
 
 	methodType := method.Type()
-	if methodType.NumIn() != 1 || methodType.NumOut() != 1 {
+	if methodType.NumIn() != 1 || methodType.NumOut() < 1 || methodType.NumOut() > 2 {
+		return nil, false
+	}
+
+	if methodType.NumOut() == 2 && methodType.Out(1) != errorType {
 		return nil, false
 	}
 
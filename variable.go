@@ -0,0 +1,31 @@
+package packed
+
+import (
+	"bytes"
+	"fmt"
+)
+
+func (p *packedProperty) writeVariableSize(buffer *bytes.Buffer, reciever string) {
+	fmt.Fprintf(buffer, "size += %s.SizeOf(&%s) - %d\n", getConverterName(p.converter.hash), reciever, p.size)
+}
+
+func (p *packedProperty) writeVariable(buffer *bytes.Buffer, structure *packedStruct, functionName, reciever string, offset *propertyOffset) {
+
+	path := fieldPath(structure, reciever)
+
+	offset.flush(buffer)
+
+	fmt.Fprintf(buffer, "if n, err := %s.%s(&%s, bytes, index); err != nil {\n", getConverterName(p.converter.hash), functionName, reciever)
+	fmt.Fprintf(buffer, "return 0, &packed.FieldError{Path: %q, Err: err}\n", path)
+	fmt.Fprintf(buffer, "} else {\n")
+	fmt.Fprintf(buffer, "index += n\n")
+	fmt.Fprintf(buffer, "}\n")
+
+	offset.minimum += p.size
+
+	if remaining := offset.remaining(structure); functionName == "FromBytes" && remaining > 0 {
+		fmt.Fprintf(buffer, "if len(bytes)-index < %d {\n", remaining)
+		fmt.Fprintf(buffer, "return 0, packed.ErrShortBuffer\n")
+		fmt.Fprintf(buffer, "}\n")
+	}
+}
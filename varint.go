@@ -0,0 +1,196 @@
+package packed
+
+import (
+	"fmt"
+)
+
+var (
+	Varint       = VarintConverter{}
+	ZigzagVarint = ZigzagVarintConverter{}
+	SignedLEB128 = SignedLEB128Converter{}
+	MQTTVarint   = MQTTVarintConverter{}
+)
+
+func uvarintSize(value uint64) int {
+	size := 1
+	for value >= 0x80 {
+		value >>= 7
+		size++
+	}
+	return size
+}
+
+func putUvarint(value uint64, bytes []byte, index int) int {
+	i := 0
+	for value >= 0x80 {
+		bytes[index+i] = byte(value) | 0x80
+		value >>= 7
+		i++
+	}
+	bytes[index+i] = byte(value)
+	return i + 1
+}
+
+func uvarint(bytes []byte, index int, maximum int) (uint64, int, error) {
+
+	var value uint64
+
+	for i := 0; i < maximum; i++ {
+
+		if index+i >= len(bytes) {
+			return 0, 0, ErrShortBuffer
+		}
+
+		b := bytes[index+i]
+
+		if i == 9 && b > 1 {
+			return 0, 0, fmt.Errorf("%w: varint exceeds 64 bits", ErrOverflow)
+		}
+
+		if i > 0 && b == 0 {
+			return 0, 0, fmt.Errorf("%w: varint is not minimally encoded", ErrInvalidValue)
+		}
+
+		value |= uint64(b&0x7F) << (7 * i)
+
+		if b < 0x80 {
+			return value, i + 1, nil
+		}
+	}
+
+	return 0, 0, fmt.Errorf("%w: varint longer than %d bytes", ErrOverflow, maximum)
+}
+
+type VarintConverter struct{}
+
+func (VarintConverter) Size() int { return 1 }
+
+func (VarintConverter) SizeOf(value *uint64) int {
+	return uvarintSize(*value)
+}
+
+func (VarintConverter) ToBytes(value *uint64, bytes []byte, index int) (int, error) {
+	return putUvarint(*value, bytes, index), nil
+}
+
+func (VarintConverter) FromBytes(receiver *uint64, bytes []byte, index int) (int, error) {
+	value, n, err := uvarint(bytes, index, 10)
+	*receiver = value
+	return n, err
+}
+
+type ZigzagVarintConverter struct{}
+
+func zigzag(value int64) uint64 {
+	return uint64(value<<1) ^ uint64(value>>63)
+}
+
+func (ZigzagVarintConverter) Size() int { return 1 }
+
+func (ZigzagVarintConverter) SizeOf(value *int64) int {
+	return uvarintSize(zigzag(*value))
+}
+
+func (ZigzagVarintConverter) ToBytes(value *int64, bytes []byte, index int) (int, error) {
+	return putUvarint(zigzag(*value), bytes, index), nil
+}
+
+func (ZigzagVarintConverter) FromBytes(receiver *int64, bytes []byte, index int) (int, error) {
+	value, n, err := uvarint(bytes, index, 10)
+	*receiver = int64(value>>1) ^ -int64(value&1)
+	return n, err
+}
+
+type SignedLEB128Converter struct{}
+
+func (SignedLEB128Converter) Size() int { return 1 }
+
+func (SignedLEB128Converter) SizeOf(value *int64) int {
+	v := *value
+	size := 1
+	for (v >= 0x40 || v < -0x40) && size < 10 {
+		v >>= 7
+		size++
+	}
+	return size
+}
+
+func (SignedLEB128Converter) ToBytes(value *int64, bytes []byte, index int) (int, error) {
+	v := *value
+	i := 0
+	for {
+		b := byte(v & 0x7F)
+		v >>= 7
+
+		if (v == 0 && b&0x40 == 0) || (v == -1 && b&0x40 != 0) {
+			bytes[index+i] = b
+			return i + 1, nil
+		}
+
+		bytes[index+i] = b | 0x80
+		i++
+	}
+}
+
+func (SignedLEB128Converter) FromBytes(receiver *int64, bytes []byte, index int) (int, error) {
+
+	var value int64
+
+	for i := 0; i < 10; i++ {
+
+		if index+i >= len(bytes) {
+			return 0, ErrShortBuffer
+		}
+
+		b := bytes[index+i]
+
+		if i == 9 && b != 0x00 && b != 0x7F {
+			return 0, fmt.Errorf("%w: signed LEB128 exceeds 64 bits", ErrOverflow)
+		}
+
+		if i > 0 && (b == 0x00 && bytes[index+i-1]&0x40 == 0 || b == 0x7F && bytes[index+i-1]&0x40 != 0) {
+			return 0, fmt.Errorf("%w: signed LEB128 is not minimally encoded", ErrInvalidValue)
+		}
+
+		value |= int64(b&0x7F) << (7 * i)
+
+		if b < 0x80 {
+			if shift := 7 * (i + 1); shift < 64 && b&0x40 != 0 {
+				value |= -1 << shift
+			}
+
+			*receiver = value
+			return i + 1, nil
+		}
+	}
+
+	return 0, fmt.Errorf("%w: signed LEB128 longer than 10 bytes", ErrOverflow)
+}
+
+const mqttVarintMaximum = 268435455
+
+type MQTTVarintConverter struct{}
+
+func (MQTTVarintConverter) Size() int { return 1 }
+
+func (MQTTVarintConverter) SizeOf(value *uint32) int {
+	if *value > mqttVarintMaximum {
+		return 4
+	}
+	return uvarintSize(uint64(*value))
+}
+
+func (MQTTVarintConverter) ToBytes(value *uint32, bytes []byte, index int) (int, error) {
+
+	if *value > mqttVarintMaximum {
+		return 0, fmt.Errorf("%w: %d exceeds the maximum remaining length %d", ErrOverflow, *value, mqttVarintMaximum)
+	}
+
+	return putUvarint(uint64(*value), bytes, index), nil
+}
+
+func (MQTTVarintConverter) FromBytes(receiver *uint32, bytes []byte, index int) (int, error) {
+	value, n, err := uvarint(bytes, index, 4)
+	*receiver = uint32(value)
+	return n, err
+}
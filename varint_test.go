@@ -0,0 +1,158 @@
+package packed
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+	"slices"
+	"testing"
+)
+
+func TestVarintConverter(t *testing.T) {
+	converter := VarintConverter{}
+
+	for _, original := range []uint64{0, 1, 127, 128, 300, 16383, 16384, math.MaxUint32, 1 << 63, math.MaxUint64} {
+		expected := binary.AppendUvarint(nil, original)
+
+		if size := converter.SizeOf(&original); size != len(expected) {
+			t.Errorf("Varint SizeOf %d: expected %d, got %d", original, len(expected), size)
+		}
+
+		bytes := make([]byte, len(expected)+1)
+
+		if n, err := converter.ToBytes(&original, bytes, 1); err != nil || n != len(expected) || !slices.Equal(bytes[1:], expected) {
+			t.Errorf("Varint ToBytes %d: expected %x, got %x (%d, %v)", original, expected, bytes[1:], n, err)
+		}
+
+		var result uint64
+		if n, err := converter.FromBytes(&result, bytes, 1); err != nil || n != len(expected) || result != original {
+			t.Errorf("Varint FromBytes %x: expected %d, got %d (%d, %v)", expected, original, result, n, err)
+		}
+	}
+
+	var result uint64
+
+	if _, err := converter.FromBytes(&result, []byte{0x80, 0x80}, 0); !errors.Is(err, ErrShortBuffer) {
+		t.Errorf("Varint FromBytes truncated: expected short buffer, got %v", err)
+	}
+
+	if _, err := converter.FromBytes(&result, []byte{0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x02}, 0); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Varint FromBytes 65 bits: expected overflow, got %v", err)
+	}
+
+	if _, err := converter.FromBytes(&result, slices.Repeat([]byte{0x80}, 11), 0); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Varint FromBytes 11 bytes: expected overflow, got %v", err)
+	}
+
+	if _, err := converter.FromBytes(&result, []byte{0x80, 0x00}, 0); !errors.Is(err, ErrInvalidValue) {
+		t.Errorf("Varint FromBytes overlong zero: expected invalid value, got %v", err)
+	}
+
+	if _, err := converter.FromBytes(&result, []byte{0xAC, 0x82, 0x00}, 0); !errors.Is(err, ErrInvalidValue) {
+		t.Errorf("Varint FromBytes overlong 300: expected invalid value, got %v", err)
+	}
+}
+
+func TestZigzagVarintConverter(t *testing.T) {
+	converter := ZigzagVarintConverter{}
+
+	for _, original := range []int64{0, -1, 1, -64, 64, -65, math.MaxInt64, math.MinInt64} {
+		expected := binary.AppendVarint(nil, original)
+		bytes := make([]byte, converter.SizeOf(&original))
+
+		if n, err := converter.ToBytes(&original, bytes, 0); err != nil || n != len(expected) || !slices.Equal(bytes, expected) {
+			t.Errorf("ZigzagVarint ToBytes %d: expected %x, got %x (%d, %v)", original, expected, bytes, n, err)
+		}
+
+		var result int64
+		if n, err := converter.FromBytes(&result, bytes, 0); err != nil || n != len(expected) || result != original {
+			t.Errorf("ZigzagVarint FromBytes %x: expected %d, got %d (%d, %v)", expected, original, result, n, err)
+		}
+	}
+}
+
+func TestSignedLEB128Converter(t *testing.T) {
+	converter := SignedLEB128Converter{}
+
+	values := map[int64][]byte{
+		0:             {0x00},
+		2:             {0x02},
+		-2:            {0x7E},
+		63:            {0x3F},
+		64:            {0xC0, 0x00},
+		-64:           {0x40},
+		-65:           {0xBF, 0x7F},
+		127:           {0xFF, 0x00},
+		-128:          {0x80, 0x7F},
+		-123456:       {0xC0, 0xBB, 0x78},
+		math.MaxInt64: {0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x00},
+		math.MinInt64: {0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x7F},
+	}
+
+	for original, expected := range values {
+		if size := converter.SizeOf(&original); size != len(expected) {
+			t.Errorf("SignedLEB128 SizeOf %d: expected %d, got %d", original, len(expected), size)
+		}
+
+		bytes := make([]byte, len(expected))
+
+		if n, err := converter.ToBytes(&original, bytes, 0); err != nil || n != len(expected) || !slices.Equal(bytes, expected) {
+			t.Errorf("SignedLEB128 ToBytes %d: expected %x, got %x (%d, %v)", original, expected, bytes, n, err)
+		}
+
+		var result int64
+		if n, err := converter.FromBytes(&result, bytes, 0); err != nil || n != len(expected) || result != original {
+			t.Errorf("SignedLEB128 FromBytes %x: expected %d, got %d (%d, %v)", expected, original, result, n, err)
+		}
+	}
+
+	var result int64
+
+	if _, err := converter.FromBytes(&result, []byte{0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x01}, 0); !errors.Is(err, ErrOverflow) {
+		t.Errorf("SignedLEB128 FromBytes 65 bits: expected overflow, got %v", err)
+	}
+
+	for _, overlong := range [][]byte{{0x80, 0x00}, {0xFF, 0x7F}, {0xC0, 0x80, 0x00}, {0xBF, 0xFF, 0x7F}} {
+		if _, err := converter.FromBytes(&result, overlong, 0); !errors.Is(err, ErrInvalidValue) {
+			t.Errorf("SignedLEB128 FromBytes overlong %x: expected invalid value, got %v", overlong, err)
+		}
+	}
+}
+
+func TestMQTTVarintConverter(t *testing.T) {
+	converter := MQTTVarintConverter{}
+
+	values := map[uint32][]byte{
+		0:         {0x00},
+		127:       {0x7F},
+		128:       {0x80, 0x01},
+		16383:     {0xFF, 0x7F},
+		16384:     {0x80, 0x80, 0x01},
+		2097151:   {0xFF, 0xFF, 0x7F},
+		2097152:   {0x80, 0x80, 0x80, 0x01},
+		268435455: {0xFF, 0xFF, 0xFF, 0x7F},
+	}
+
+	for original, expected := range values {
+		bytes := make([]byte, converter.SizeOf(&original))
+
+		if n, err := converter.ToBytes(&original, bytes, 0); err != nil || n != len(expected) || !slices.Equal(bytes, expected) {
+			t.Errorf("MQTTVarint ToBytes %d: expected %x, got %x (%d, %v)", original, expected, bytes, n, err)
+		}
+
+		var result uint32
+		if n, err := converter.FromBytes(&result, bytes, 0); err != nil || n != len(expected) || result != original {
+			t.Errorf("MQTTVarint FromBytes %x: expected %d, got %d (%d, %v)", expected, original, result, n, err)
+		}
+	}
+
+	overflow := uint32(268435456)
+	if _, err := converter.ToBytes(&overflow, make([]byte, 5), 0); !errors.Is(err, ErrOverflow) {
+		t.Errorf("MQTTVarint ToBytes %d: expected overflow, got %v", overflow, err)
+	}
+
+	var result uint32
+	if _, err := converter.FromBytes(&result, []byte{0xFF, 0xFF, 0xFF, 0xFF, 0x01}, 0); !errors.Is(err, ErrOverflow) {
+		t.Errorf("MQTTVarint FromBytes 5 bytes: expected overflow, got %v", err)
+	}
+}